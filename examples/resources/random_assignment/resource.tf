@@ -0,0 +1,11 @@
+# The following example shows how to roll a new checkout flow out to 25% of
+# tenants, with each tenant consistently in or out of the rollout.
+
+resource "random_assignment" "new_checkout" {
+  for_each = toset(var.tenant_ids)
+
+  key        = each.value
+  buckets    = 4
+  salt       = "new-checkout"
+  percentage = 25
+}
@@ -0,0 +1,12 @@
+# The following example generates an 8-bit permission mask for a test fixture,
+# in which bit 0 (read) is always granted and bit 7 (admin) never is.
+
+resource "random_bitmask" "permissions" {
+  bits      = 8
+  fixed_on  = [0]
+  fixed_off = [7]
+}
+
+output "can_write" {
+  value = random_bitmask.permissions.flags[1]
+}
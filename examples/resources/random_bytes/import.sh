@@ -0,0 +1,2 @@
+# Random bytes can be imported by specifying the value as base64 string.
+terraform import random_bytes.basic "8/fu3q+2DcgSJ19i0jZ5Cw=="
\ No newline at end of file
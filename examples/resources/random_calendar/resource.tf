@@ -0,0 +1,12 @@
+# The following example schedules a maintenance window on a random weekday
+# morning, so that not every environment is patched at the same time.
+
+resource "random_calendar" "maintenance" {
+  business_start = "06:00"
+  business_end   = "10:00"
+  days           = ["tuesday", "wednesday", "thursday"]
+}
+
+output "maintenance_window" {
+  value = random_calendar.maintenance.result
+}
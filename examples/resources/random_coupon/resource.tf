@@ -0,0 +1,14 @@
+# The following example shows how to generate a batch of coupon codes
+# such as "7QK4-ZC9N-W2HT" for a marketing campaign.
+
+resource "random_coupon" "spring_sale" {
+  count = 100
+
+  length   = 12
+  segments = 3
+
+  keepers = {
+    # Generate new codes each time the campaign changes
+    campaign = var.campaign_id
+  }
+}
@@ -0,0 +1,18 @@
+# The following example rolls the hit points of a character.
+
+variable "character_name" {
+  type = string
+}
+
+resource "random_dice" "hit_points" {
+  dice = "3d6+2"
+
+  keepers = {
+    # Roll new hit points when the character is renamed.
+    character = var.character_name
+  }
+}
+
+output "hit_points" {
+  value = random_dice.hit_points.result
+}
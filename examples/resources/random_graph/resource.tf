@@ -0,0 +1,15 @@
+# The following example shows how to generate a reproducible service
+# dependency graph as a fixture for testing a scheduler.
+
+resource "random_graph" "dependencies" {
+  nodes            = 20
+  edge_probability = 0.1
+  directed         = true
+  seed             = "scheduler-fixtures"
+}
+
+output "dependencies" {
+  value = [
+    for edge in random_graph.dependencies.edges : "service-${edge.from} -> service-${edge.to}"
+  ]
+}
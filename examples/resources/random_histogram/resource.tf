@@ -0,0 +1,21 @@
+# The following example shows how to generate a realistic mix of
+# test users, following the plan distribution observed in production.
+
+resource "random_histogram" "plans" {
+  frequencies = {
+    free       = 820
+    team       = 150
+    enterprise = 30
+  }
+
+  draws = 50
+  seed  = "fixtures"
+}
+
+module "fixture_users" {
+  source   = "./modules/fixture_users"
+  for_each = random_histogram.plans.result
+
+  plan       = each.key
+  user_count = each.value
+}
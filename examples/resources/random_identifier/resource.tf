@@ -0,0 +1,10 @@
+# The following example names a storage bucket with a random DNS label, so that
+# the name is always accepted by the provider of the bucket.
+
+resource "random_identifier" "bucket" {
+  length = 20
+}
+
+resource "aws_s3_bucket" "example" {
+  bucket = random_identifier.bucket.result
+}
@@ -3,4 +3,7 @@
 # interpolated from the random provider without experiencing diffs.
 
 # Example (values are separated by a ,):
-terraform import random_integer.priority 15390,1,50000
\ No newline at end of file
+terraform import random_integer.priority 15390,1,50000
+
+# The result, min, and max can also be supplied in hexadecimal, prefixed with 0x:
+terraform import random_integer.priority 0x3c1e,0x1,0xc350
\ No newline at end of file
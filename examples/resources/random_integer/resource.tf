@@ -20,3 +20,28 @@ resource "aws_alb_listener_rule" "main" {
   }
   # ... (other aws_alb_listener_rule arguments) ...
 }
+
+# The following example shows how to map a tenant onto one of 16 shards. The
+# same tenant ID always maps onto the same shard.
+
+resource "random_integer" "shard" {
+  min = 0
+  max = 15
+  key = var.tenant_id
+}
+
+# The following example shows how to place a service in a 25% rollout. The
+# seed keeps the service in the same bucket, and the condition is evaluated
+# after the integer has been generated, so it cannot be used in count.
+
+resource "random_integer" "rollout" {
+  min  = 0
+  max  = 99
+  seed = var.service_name
+}
+
+resource "aws_ssm_parameter" "new_checkout" {
+  name  = "/${var.service_name}/new_checkout"
+  type  = "String"
+  value = random_integer.rollout.percentile < 25 ? "enabled" : "disabled"
+}
@@ -0,0 +1,13 @@
+# The following example fills the descriptions of demo products with distinct
+# placeholder sentences, which stay the same on every apply.
+
+resource "random_line" "descriptions" {
+  result_count = 3
+  unique       = true
+  seed         = "demo"
+}
+
+resource "example_product" "demo" {
+  count       = 3
+  description = random_line.descriptions.results[count.index]
+}
@@ -0,0 +1,14 @@
+# The following example assigns every participant of a gift exchange
+# another participant to give a gift to.
+
+variable "participants" {
+  type = list(string)
+}
+
+resource "random_permutation_cycle" "secret_santa" {
+  input = var.participants
+}
+
+locals {
+  gives_to = zipmap(var.participants, random_permutation_cycle.secret_santa.result)
+}
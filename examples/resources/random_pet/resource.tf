@@ -20,3 +20,20 @@ resource "aws_instance" "server" {
 
   # ... (other aws_instance arguments) ...
 }
+
+# The following example shows how to generate space-themed pet names three
+# times as often as sea-themed ones.
+resource "random_pet" "themed" {
+  length = 3
+
+  word_pools = {
+    space = {
+      weight = 3
+      words  = ["comet", "nebula", "orbit", "quasar"]
+    }
+    sea = {
+      weight = 1
+      words  = ["otter", "kelp", "reef"]
+    }
+  }
+}
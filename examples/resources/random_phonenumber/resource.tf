@@ -0,0 +1,11 @@
+# The following example generates fake contact numbers for fixtures.
+
+resource "random_phonenumber" "contact" {
+  count = 3
+
+  format = "national"
+}
+
+output "contacts" {
+  value = random_phonenumber.contact[*].result
+}
@@ -0,0 +1,20 @@
+# The following example runs a nightly backup at a random time between
+# 01:00 and 04:59, so that the backups of many databases do not start
+# at the same time.
+
+resource "random_schedule" "backup" {
+  hour_min = 1
+  hour_max = 4
+
+  keepers = {
+    database = var.database_name
+  }
+}
+
+variable "database_name" {
+  type = string
+}
+
+output "backup_schedule" {
+  value = random_schedule.backup.result
+}
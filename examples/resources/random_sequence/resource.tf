@@ -0,0 +1,16 @@
+# The following example shows how to allocate an increasing, but
+# hard to enumerate, build number each time a new release is cut.
+
+resource "random_sequence" "build" {
+  min_step = 1
+  max_step = 1000
+
+  trigger = {
+    # Advance the build number each time the release tag changes
+    release = var.release_tag
+  }
+}
+
+output "build_number" {
+  value = random_sequence.build.current
+}
@@ -0,0 +1,10 @@
+# Random shuffles can be imported using the elements of the result, in order,
+# separated by a ,. The input should then be set in the configuration, and the
+# next apply stores it, along with any other arguments, without recreating the
+# resource. The imported result is kept whatever the seed, as the seed used to
+# produce the original ordering cannot be recovered, but the apply fails if the
+# result contradicts the arguments, e.g. if result_count differs from its number
+# of elements.
+
+# Example:
+terraform import random_shuffle.az us-west-1c,us-west-1a,us-west-1d
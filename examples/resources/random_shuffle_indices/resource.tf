@@ -0,0 +1,17 @@
+# The following example shows how to shuffle a list of objects, which
+# random_shuffle cannot accept as input.
+
+variable "servers" {
+  type = list(object({
+    name = string
+    zone = string
+  }))
+}
+
+resource "random_shuffle_indices" "servers" {
+  length = length(var.servers)
+}
+
+locals {
+  shuffled_servers = [for i in random_shuffle_indices.servers.result : var.servers[i]]
+}
@@ -0,0 +1,11 @@
+# The following example picks two ports in a random order, keeping them as
+# numbers so that they can be used without conversion.
+
+resource "random_shuffle_number" "ports" {
+  input        = [8080, 8081, 8082, 8083]
+  result_count = 2
+}
+
+output "primary_port" {
+  value = random_shuffle_number.ports.result[0]
+}
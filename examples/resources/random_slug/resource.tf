@@ -0,0 +1,11 @@
+# The following example shows how to generate a URL slug for a landing
+# page, such as "wholly-sharp-lemur", that stays the same until the page
+# is renamed.
+
+resource "random_slug" "landing_page" {
+  word_count = 3
+
+  keepers = {
+    page = var.page_name
+  }
+}
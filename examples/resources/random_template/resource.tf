@@ -0,0 +1,8 @@
+# The following example shows how to generate a username for a test
+# account, such as "user-4821-lemur", that stays the same for a given
+# environment.
+
+resource "random_template" "username" {
+  template = "user-{int:1000-9999}-{word}"
+  seed     = var.environment
+}
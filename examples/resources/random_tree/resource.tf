@@ -0,0 +1,27 @@
+# The following example shows how to generate a reproducible, randomly
+# nested settings document as a fixture for a configuration parser.
+
+resource "random_tree" "settings" {
+  max_depth   = 4
+  max_breadth = 5
+  leaf_type   = "string"
+  seed        = "parser-fixtures"
+}
+
+resource "local_file" "settings" {
+  filename = "${path.module}/fixtures/settings.json"
+  content  = random_tree.settings.result
+}
+
+# The same tree can be encoded as YAML or TOML instead.
+resource "random_tree" "settings_yaml" {
+  max_depth     = 4
+  max_breadth   = 5
+  output_format = "yaml"
+  seed          = "parser-fixtures"
+}
+
+resource "local_file" "settings_yaml" {
+  filename = "${path.module}/fixtures/settings.yaml"
+  content  = random_tree.settings_yaml.result
+}
@@ -7,3 +7,10 @@ resource "azurerm_resource_group" "test" {
   name     = "${random_uuid.test.result}-rg"
   location = "Central US"
 }
+
+# Version 7 UUIDs are time-ordered, and the results of a single resource are
+# strictly increasing.
+resource "random_uuid" "ordered" {
+  version      = "7"
+  result_count = 3
+}
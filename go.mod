@@ -3,7 +3,6 @@ module github.com/terraform-providers/terraform-provider-random
 go 1.17
 
 require (
-	github.com/dustinkirkland/golang-petname v0.0.0-20191129215211-8e5a1ed0cff0
 	github.com/google/go-cmp v0.5.8
 	github.com/hashicorp/go-uuid v1.0.3
 	github.com/hashicorp/terraform-plugin-docs v0.13.0
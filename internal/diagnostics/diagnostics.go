@@ -1,9 +1,13 @@
 package diagnostics
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
 const RetryMsg = "Retry the Terraform operation. If the error still occurs or happens regularly, please contact the provider developer with hardware and operating system information.\n\n"
@@ -21,6 +25,25 @@ func RandomReadError(errMsg string) diag.Diagnostics {
 	return diags
 }
 
+// GenerationError returns the diagnostics for an error returned by a generation function of the random package.
+// Errors of the kinds that random returns for parameters that no value can be generated from are reported with the
+// given summary as a problem with the configuration, as retrying would not help. All other errors are reported as
+// by RandomReadError.
+func GenerationError(summary string, err error) diag.Diagnostics {
+	if !errors.Is(err, random.ErrRangeEmpty) && !errors.Is(err, random.ErrConstraintUnsatisfiable) && !errors.Is(err, random.ErrInvalidParams) {
+		return RandomReadError(err.Error())
+	}
+
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		summary,
+		fmt.Sprintf("No value can be generated from the given arguments: %s.", err),
+	)
+
+	return diags
+}
+
 func HashGenerationError(errMsg string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -46,3 +69,39 @@ func RandomnessGenerationError(errMsg string) diag.Diagnostics {
 
 	return diags
 }
+
+// Redacted replaces sensitive values embedded in diagnostic text.
+const Redacted = "(sensitive value)"
+
+// Redact returns diags with every occurrence of secret in the summary and detail of each diagnostic replaced by
+// Redacted, preserving severity and attribute paths. Diagnostics are returned unchanged when secret is empty.
+func Redact(diags diag.Diagnostics, secret string) diag.Diagnostics {
+	if secret == "" {
+		return diags
+	}
+
+	redacted := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		summary := strings.ReplaceAll(d.Summary(), secret, Redacted)
+		detail := strings.ReplaceAll(d.Detail(), secret, Redacted)
+
+		if summary == d.Summary() && detail == d.Detail() {
+			redacted = append(redacted, d)
+			continue
+		}
+
+		var r diag.Diagnostic = diag.NewErrorDiagnostic(summary, detail)
+		if d.Severity() == diag.SeverityWarning {
+			r = diag.NewWarningDiagnostic(summary, detail)
+		}
+
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			r = diag.WithPath(withPath.Path(), r)
+		}
+
+		redacted = append(redacted, r)
+	}
+
+	return redacted
+}
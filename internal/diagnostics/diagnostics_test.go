@@ -0,0 +1,87 @@
+package diagnostics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestGenerationError(t *testing.T) {
+	_, err := random.ParseCharsetSpec("")
+
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic("Create Error", "No value can be generated from the given arguments: the character set is empty."),
+	}
+	if got := GenerationError("Create Error", err); !got.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	readErr := errors.New("short read")
+	if got, expected := GenerationError("Create Error", readErr), RandomReadError(readErr.Error()); !got.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	cases := []struct {
+		name     string
+		diags    diag.Diagnostics
+		secret   string
+		expected diag.Diagnostics
+	}{
+		{
+			name: "error",
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Failed with s3cr3t", "The value s3cr3t is invalid: s3cr3t"),
+			},
+			secret: "s3cr3t",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Failed with (sensitive value)", "The value (sensitive value) is invalid: (sensitive value)"),
+			},
+		},
+		{
+			name: "warning with path",
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("result"), "Warning", "Got s3cr3t"),
+			},
+			secret: "s3cr3t",
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("result"), "Warning", "Got (sensitive value)"),
+			},
+		},
+		{
+			name: "unrelated",
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Failed", "Something went wrong"),
+			},
+			secret: "s3cr3t",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Failed", "Something went wrong"),
+			},
+		},
+		{
+			name: "empty secret",
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Failed", "Something went wrong"),
+			},
+			secret: "",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Failed", "Something went wrong"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := Redact(c.diags, c.secret)
+
+			if !actual.Equal(c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+const collisionGroupDescription = "Name of a group of resources whose results must not collide. A result that " +
+	"has already been generated by another resource in the same group is re-drawn.\n" +
+	"\n" +
+	"**Note:** Results are only compared within a single Terraform run, e.g. between resources created by the " +
+	"same `terraform apply`. Results stored in state by previous runs are not taken into account."
+
+// collisionRegistry records the values generated for each collision group by a provider instance. As Terraform
+// starts a new provider instance for every operation, values are only tracked for the duration of a single run,
+// and values already stored in state are not taken into account.
+type collisionRegistry struct {
+	mu     sync.Mutex
+	groups map[string]map[string]struct{}
+}
+
+func newCollisionRegistry() *collisionRegistry {
+	return &collisionRegistry{
+		groups: make(map[string]map[string]struct{}),
+	}
+}
+
+// register records value as generated within group. It returns false, and leaves the registry unchanged, if the
+// value has already been registered within the same group.
+func (c *collisionRegistry) register(group, value string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	values, ok := c.groups[group]
+	if !ok {
+		values = make(map[string]struct{})
+		c.groups[group] = values
+	}
+
+	if _, ok := values[value]; ok {
+		return false
+	}
+
+	values[value] = struct{}{}
+
+	return true
+}
+
+func collisionError(summary, group string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		summary,
+		fmt.Sprintf("Unable to generate a result that does not collide with the other results in collision group %q ", group)+
+			fmt.Sprintf("within %d attempts. Reduce the number of resources in the group or increase the number of ", random.MaxAttempts)+
+			"possible results.",
+	)
+
+	return diags
+}
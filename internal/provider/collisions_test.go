@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCollisionRegistry(t *testing.T) {
+	c := newCollisionRegistry()
+
+	if !c.register("a", "value") {
+		t.Fatal("expected first registration to succeed")
+	}
+
+	if c.register("a", "value") {
+		t.Fatal("expected duplicate registration within a group to fail")
+	}
+
+	if !c.register("b", "value") {
+		t.Fatal("expected registration of the same value in another group to succeed")
+	}
+}
+
+func TestCollisionRegistry_Concurrent(t *testing.T) {
+	c := newCollisionRegistry()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	registered := 0
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			if c.register("group", fmt.Sprintf("%d", i%10)) {
+				mu.Lock()
+				registered++
+				mu.Unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if registered != 10 {
+		t.Errorf("expected 10 successful registrations, got %d", registered)
+	}
+}
+
+// testAccCheckDistinctResults checks that the given attribute differs between all count instances of the named
+// resource.
+func testAccCheckDistinctResults(name string, count int, attribute string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		seen := make(map[string]string, count)
+
+		for i := 0; i < count; i++ {
+			instance := fmt.Sprintf("%s.%d", name, i)
+
+			rs, ok := s.RootModule().Resources[instance]
+			if !ok {
+				return fmt.Errorf("not found: %s", instance)
+			}
+
+			v := rs.Primary.Attributes[attribute]
+			if other, ok := seen[v]; ok {
+				return fmt.Errorf("%s and %s both have %s %q", other, instance, attribute, v)
+			}
+
+			seen[v] = instance
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// stringEnum lists the allowed values of an enum-like string attribute. The provider schema sent to Terraform
+// carries descriptions but not validators, so deriving both the validator and the list of values in the
+// description from a stringEnum keeps the values that tooling and documentation can see in line with those that
+// are accepted.
+type stringEnum []string
+
+// Validator returns a validator accepting only the values in e.
+func (e stringEnum) Validator() tfsdk.AttributeValidator {
+	return stringvalidator.OneOf(e...)
+}
+
+// Description returns a sentence listing the values in e, e.g. "Valid values are `a`, `b` and `c`.".
+func (e stringEnum) Description() string {
+	quoted := make([]string, len(e))
+	for i, v := range e {
+		quoted[i] = fmt.Sprintf("`%s`", v)
+	}
+
+	if len(quoted) == 1 {
+		return fmt.Sprintf("The only valid value is %s.", quoted[0])
+	}
+
+	return fmt.Sprintf("Valid values are %s and %s.", strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1])
+}
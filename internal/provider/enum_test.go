@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestStringEnumDescription(t *testing.T) {
+	cases := []struct {
+		name     string
+		enum     stringEnum
+		expected string
+	}{
+		{
+			name:     "one",
+			enum:     stringEnum{"json"},
+			expected: "The only valid value is `json`.",
+		},
+		{
+			name:     "two",
+			enum:     stringEnum{"4", "7"},
+			expected: "Valid values are `4` and `7`.",
+		},
+		{
+			name:     "three",
+			enum:     stringEnum{"none", "luhn", "verhoeff"},
+			expected: "Valid values are `none`, `luhn` and `verhoeff`.",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.enum.Description()
+
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
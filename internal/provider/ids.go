@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Every resource derives its id with one of the functions below, so that tooling can predict the id of any
+// resource from its type alone:
+//
+//   - Resources with a single, non-sensitive result that identifies them, i.e. random_id, random_integer,
+//     random_pet, random_string and random_uuid, use resultID.
+//   - random_password uses sensitiveID, so that its id never reveals the result.
+//   - All other resources use staticID, as their results are not a single value.
+//
+// Ids are only derived when a resource is created or imported. The id of an existing resource is kept in state
+// as-is, so that changing these functions never changes the id of a resource that already exists.
+
+// resultID returns the id of a resource whose result is the given value, in the form it is stored in `result`,
+// or `b64_url` for random_id.
+func resultID(result string) types.String {
+	return types.String{Value: result}
+}
+
+// sensitiveID returns the id of a resource whose result is sensitive.
+func sensitiveID() types.String {
+	return types.String{Value: "none"}
+}
+
+// staticID returns the id of a resource whose result is not a single value.
+func staticID() types.String {
+	return types.String{Value: "-"}
+}
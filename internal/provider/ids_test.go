@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceIDs(t *testing.T) {
+	t.Parallel()
+
+	config := `resource "random_id" "id" {
+					byte_length = 4
+				}
+				resource "random_integer" "integer" {
+					min = 1
+					max = 100
+				}
+				resource "random_password" "password" {
+					length = 12
+				}
+				resource "random_uuid" "uuid" {
+				}
+				resource "random_shuffle" "shuffle" {
+					input = ["a", "b", "c"]
+				}`
+
+	var id, integer, uuid string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("random_id.id", "id", "random_id.id", "b64_url"),
+					resource.TestCheckResourceAttrPair("random_integer.integer", "id", "random_integer.integer", "result"),
+					resource.TestCheckResourceAttr("random_password.password", "id", "none"),
+					resource.TestCheckResourceAttrPair("random_uuid.uuid", "id", "random_uuid.uuid", "result"),
+					resource.TestCheckResourceAttr("random_shuffle.shuffle", "id", "-"),
+					testAccCheckAttrCapture("random_id.id", "id", &id),
+					testAccCheckAttrCapture("random_integer.integer", "id", &integer),
+					testAccCheckAttrCapture("random_uuid.uuid", "id", &uuid),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttrEquals("random_id.id", "id", &id),
+					testAccCheckAttrEquals("random_integer.integer", "id", &integer),
+					testAccCheckAttrEquals("random_uuid.uuid", "id", &uuid),
+				),
+			},
+		},
+	})
+}
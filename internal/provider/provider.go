@@ -2,38 +2,109 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func New() tfsdk.Provider {
-	return &provider{}
+	return NewWithWordLists(defaultWordLists{})
+}
+
+// NewWithWordLists returns the provider with random_pet drawing names from the word lists supplied by words instead
+// of the built-in lists.
+func NewWithWordLists(words WordListProvider) tfsdk.Provider {
+	return &provider{
+		collisions: newCollisionRegistry(),
+		source:     &randSource{},
+		words:      words,
+		now:        time.Now,
+	}
 }
 
 var _ tfsdk.Provider = (*provider)(nil)
 
-type provider struct{}
+type provider struct {
+	collisions *collisionRegistry
+	source     *randSource
+	words      WordListProvider
+	// now returns the current time, and is replaced in tests of resources that depend on it.
+	now func() time.Time
+}
 
 func (p *provider) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
-	return tfsdk.Schema{}, nil
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"default_source": {
+				Description: "The random number generator used by resources that are not seeded and do not " +
+					"always use a cryptographic random number generator, such as `random_integer`, " +
+					"`random_pet` and `random_shuffle`. With `crypto`, their results are drawn from a " +
+					"cryptographic random number generator. With `math`, they are drawn from a " +
+					"non-cryptographic random number generator seeded with the current time. Setting `seed` " +
+					"on a resource always makes its results reproducible, whatever the value of " +
+					"`default_source`. `random_bytes`, `random_id`, `random_password`, `random_string` and " +
+					"`random_uuid` always use a cryptographic random number generator when not seeded. " +
+					sourceKinds.Description() + " Default value is `math`.",
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					sourceKinds.Validator(),
+				},
+			},
+		},
+	}, nil
 }
 
-func (p *provider) Configure(context.Context, tfsdk.ConfigureProviderRequest, *tfsdk.ConfigureProviderResponse) {
+// Configure stores the default_source argument in the random source shared with the resources.
+func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+	var config providerModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p.source.crypto = config.DefaultSource.Value == sourceCrypto
 }
 
 func (p *provider) GetResources(context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
-		"random_id":       &idResourceType{},
-		"random_integer":  &integerResourceType{},
-		"random_password": &passwordResourceType{},
-		"random_pet":      &petResourceType{},
-		"random_shuffle":  &shuffleResourceType{},
-		"random_string":   &stringResourceType{},
-		"random_uuid":     &uuidResourceType{},
+		"random_assignment":        &assignmentResourceType{},
+		"random_bitmask":           &bitmaskResourceType{},
+		"random_bytes":             &bytesResourceType{},
+		"random_calendar":          &calendarResourceType{},
+		"random_coupon":            &couponResourceType{},
+		"random_dice":              &diceResourceType{},
+		"random_graph":             &graphResourceType{},
+		"random_histogram":         &histogramResourceType{},
+		"random_id":                &idResourceType{},
+		"random_identifier":        &identifierResourceType{},
+		"random_integer":           &integerResourceType{},
+		"random_line":              &lineResourceType{},
+		"random_password":          &passwordResourceType{},
+		"random_permutation_cycle": &permutationCycleResourceType{},
+		"random_phonenumber":       &phoneNumberResourceType{},
+		"random_pet":               &petResourceType{},
+		"random_schedule":          &scheduleResourceType{},
+		"random_sequence":          &sequenceResourceType{},
+		"random_shuffle":           &shuffleResourceType{},
+		"random_shuffle_indices":   &shuffleIndicesResourceType{},
+		"random_shuffle_number":    &shuffleNumberResourceType{},
+		"random_slug":              &slugResourceType{},
+		"random_string":            &stringResourceType{},
+		"random_template":          &templateResourceType{},
+		"random_tree":              &treeResourceType{},
+		"random_uuid":              &uuidResourceType{},
 	}, nil
 }
 
 func (p *provider) GetDataSources(context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	return map[string]tfsdk.DataSourceType{}, nil
 }
+
+type providerModel struct {
+	DefaultSource types.String `tfsdk:"default_source"`
+}
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,6 +15,17 @@ func protoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, err
 	}
 }
 
+// clockProviderFactories returns provider factories whose resources read the current time from now instead of the
+// system clock.
+func clockProviderFactories(now func() time.Time) map[string]func() (tfprotov6.ProviderServer, error) {
+	p := New().(*provider)
+	p.now = now
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"random": providerserver.NewProtocol6WithError(p),
+	}
+}
+
 func providerVersion332() map[string]resource.ExternalProvider {
 	return map[string]resource.ExternalProvider{
 		"tls": {
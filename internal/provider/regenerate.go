@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// regenerateOnAttribute returns the schema of the optional regenerate_on attribute, which replaces the resource
+// whenever its values change.
+func regenerateOnAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "Arbitrary set of values that, when changed, will trigger regeneration of the result, " +
+			"e.g. a rotation date. It behaves like `keepers`, but is intended only for rotation triggers: " +
+			"`keepers` describe the values that the result belongs to and can be referenced through the " +
+			"resource, whereas `regenerate_on` records when the result should be replaced. As a set, the " +
+			"order of its values does not matter.",
+		Type: types.SetType{
+			ElemType: types.StringType,
+		},
+		Optional: true,
+		PlanModifiers: []tfsdk.AttributePlanModifier{
+			tfsdk.RequiresReplace(),
+		},
+	}
+}
+
+// nonceAttribute returns the schema of the optional nonce attribute, which replaces the resource whenever it is
+// changed, so that the result can be regenerated by incrementing a number.
+func nonceAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "Arbitrary number that, when changed, will trigger regeneration of the result, e.g. " +
+			"incremented to rotate the result without changing any other argument. Unlike `keepers`, the " +
+			"nonce does not describe the values that the result belongs to, and unlike `regenerate_on`, it " +
+			"does not record why the result was replaced: it is only a lever to regenerate the result.",
+		Type:     types.Int64Type,
+		Optional: true,
+		PlanModifiers: []tfsdk.AttributePlanModifier{
+			tfsdk.RequiresReplace(),
+		},
+	}
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceRegenerateOn(t *testing.T) {
+	testCases := map[string]struct {
+		resourceType string
+		arguments    string
+		attribute    string
+	}{
+		"bytes": {
+			resourceType: "random_bytes",
+			arguments:    "length = 16",
+			attribute:    "base64",
+		},
+		"password": {
+			resourceType: "random_password",
+			arguments:    "length = 16",
+			attribute:    "result",
+		},
+		"string": {
+			resourceType: "random_string",
+			arguments:    "length = 16",
+			attribute:    "result",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			address := testCase.resourceType + ".test"
+			config := func(regenerateOn string) string {
+				return fmt.Sprintf(`resource %q "test" {
+							%s
+							regenerate_on = %s
+						}`, testCase.resourceType, testCase.arguments, regenerateOn)
+			}
+
+			var result string
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config: config(`["2026-01", "primary"]`),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(address, "regenerate_on.#", "2"),
+							testAccCheckAttrCapture(address, testCase.attribute, &result),
+						),
+					},
+					{
+						Config:   config(`["primary", "2026-01"]`),
+						PlanOnly: true,
+					},
+					{
+						Config: config(`["primary", "2026-01"]`),
+						Check:  testAccCheckAttrEquals(address, testCase.attribute, &result),
+					},
+					{
+						Config: config(`["2026-02", "primary"]`),
+						Check:  testAccCheckAttrChanged(address, testCase.attribute, &result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccResourceNonce(t *testing.T) {
+	testCases := map[string]struct {
+		resourceType string
+		attribute    string
+	}{
+		"bytes": {
+			resourceType: "random_bytes",
+			attribute:    "base64",
+		},
+		"password": {
+			resourceType: "random_password",
+			attribute:    "result",
+		},
+		"string": {
+			resourceType: "random_string",
+			attribute:    "result",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			address := testCase.resourceType + ".test"
+			config := func(nonce string) string {
+				return fmt.Sprintf(`resource %q "test" {
+							length  = 16
+							keepers = { owner = "team" }
+							%s
+						}`, testCase.resourceType, nonce)
+			}
+
+			var result string
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config: config(""),
+						Check:  testAccCheckAttrCapture(address, testCase.attribute, &result),
+					},
+					{
+						Config: config("nonce = 1"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(address, "nonce", "1"),
+							testAccCheckAttrChanged(address, testCase.attribute, &result),
+							testAccCheckAttrCapture(address, testCase.attribute, &result),
+						),
+					},
+					{
+						Config: config("nonce = 1"),
+						Check:  testAccCheckAttrEquals(address, testCase.attribute, &result),
+					},
+					{
+						Config: config("nonce = 2"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(address, "nonce", "2"),
+							resource.TestCheckResourceAttr(address, "length", "16"),
+							resource.TestCheckResourceAttr(address, "keepers.owner", "team"),
+							testAccCheckAttrChanged(address, testCase.attribute, &result),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+// testAccCheckAttrCapture stores the value of the given attribute of the named resource in value.
+func testAccCheckAttrCapture(name, attribute string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		*value = rs.Primary.Attributes[attribute]
+
+		return nil
+	}
+}
+
+// testAccCheckAttrEquals checks that the given attribute of the named resource still equals the captured value.
+func testAccCheckAttrEquals(name, attribute string, value *string) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith(name, attribute, func(input string) error {
+		if input != *value {
+			return fmt.Errorf("expected %s to be unchanged (%q), got %q", attribute, *value, input)
+		}
+
+		return nil
+	})
+}
+
+// testAccCheckAttrChanged checks that the given attribute of the named resource differs from the captured value.
+func testAccCheckAttrChanged(name, attribute string, value *string) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith(name, attribute, func(input string) error {
+		if input == *value {
+			return fmt.Errorf("expected %s to be regenerated, got unchanged %q", attribute, input)
+		}
+
+		return nil
+	})
+}
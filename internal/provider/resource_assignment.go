@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.ResourceType = (*assignmentResourceType)(nil)
+
+type assignmentResourceType struct{}
+
+func (r *assignmentResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_assignment` deterministically assigns a key, such as a user ID, to " +
+			"one of a number of buckets, e.g. for feature flag rollouts.\n" +
+			"\n" +
+			"Unlike the other resources in this provider, `random_assignment` does not use randomness: the " +
+			"assignment is derived from the 64-bit FNV-1a hash of `salt` and `key`, so the same key, salt and " +
+			"number of buckets always produce the same `bucket`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"key": {
+				Description: "The key to assign to a bucket.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"buckets": {
+				Description: "The number of buckets to assign the key to. The minimum value is 1.",
+				Type:        types.Int64Type,
+				Required:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"salt": {
+				Description: "Arbitrary string hashed along with `key`. Changing the salt reshuffles every " +
+					"key, so that different rollouts using the same keys are independent of each other.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"percentage": {
+				Description: "The percentage of keys, between `0` and `100`, for which `in_rollout` is `true`. " +
+					"Increasing the percentage only ever adds keys to the rollout.",
+				Type:     types.Float64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					float64validator.Between(0, 100),
+				},
+			},
+			"bucket": {
+				Description: "The bucket the key is assigned to, between `0` and `buckets - 1`.",
+				Type:        types.Int64Type,
+				Computed:    true,
+			},
+			"in_rollout": {
+				Description: "Whether the key falls within `percentage`. Only set when `percentage` is set.",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *assignmentResourceType) NewResource(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &assignmentResource{}, nil
+}
+
+var _ tfsdk.Resource = (*assignmentResource)(nil)
+
+type assignmentResource struct{}
+
+func (r *assignmentResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan assignmentModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(plan.Salt.Value))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(plan.Key.Value))
+	sum := h.Sum64()
+
+	a := assignmentModelV0{
+		ID:         staticID(),
+		Keepers:    plan.Keepers,
+		Key:        plan.Key,
+		Buckets:    plan.Buckets,
+		Salt:       plan.Salt,
+		Percentage: plan.Percentage,
+		Bucket:     types.Int64{Value: int64(sum % uint64(plan.Buckets.Value))},
+	}
+
+	if plan.Percentage.Null {
+		a.InRollout.Null = true
+	} else {
+		// The rollout position is taken from the upper half of the hash so that it is independent of the
+		// bucket, which is mostly determined by the lower bits.
+		position := float64(sum>>32) / float64(math.MaxUint32+1)
+		a.InRollout.Value = position*100 < plan.Percentage.Value
+	}
+
+	diags = resp.State.Set(ctx, a)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *assignmentResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *assignmentResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *assignmentResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+type assignmentModelV0 struct {
+	ID         types.String  `tfsdk:"id"`
+	Keepers    types.Map     `tfsdk:"keepers"`
+	Key        types.String  `tfsdk:"key"`
+	Buckets    types.Int64   `tfsdk:"buckets"`
+	Salt       types.String  `tfsdk:"salt"`
+	Percentage types.Float64 `tfsdk:"percentage"`
+	Bucket     types.Int64   `tfsdk:"bucket"`
+	InRollout  types.Bool    `tfsdk:"in_rollout"`
+}
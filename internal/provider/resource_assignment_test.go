@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAssignment(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_assignment" "user_1" {
+							key     = "user-1"
+							buckets = 10
+						}
+						resource "random_assignment" "user_1_salted" {
+							key     = "user-1"
+							buckets = 10
+							salt    = "new-checkout"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_assignment.user_1", "bucket", "8"),
+					resource.TestCheckResourceAttr("random_assignment.user_1_salted", "bucket", "3"),
+					resource.TestCheckNoResourceAttr("random_assignment.user_1", "in_rollout"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceAssignment_Percentage(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_assignment" "none" {
+							key        = "user-1"
+							buckets    = 1
+							percentage = 0
+						}
+						resource "random_assignment" "all" {
+							key        = "user-1"
+							buckets    = 1
+							percentage = 100
+						}
+						resource "random_assignment" "below" {
+							key        = "user-1"
+							buckets    = 1
+							percentage = 89
+						}
+						resource "random_assignment" "above" {
+							key        = "user-1"
+							buckets    = 1
+							percentage = 90
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_assignment.none", "bucket", "0"),
+					resource.TestCheckResourceAttr("random_assignment.none", "in_rollout", "false"),
+					resource.TestCheckResourceAttr("random_assignment.all", "in_rollout", "true"),
+					resource.TestCheckResourceAttr("random_assignment.below", "in_rollout", "false"),
+					resource.TestCheckResourceAttr("random_assignment.above", "in_rollout", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceAssignment_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_assignment" "user_1" {
+							key     = "user-1"
+							buckets = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+			{
+				Config: `resource "random_assignment" "user_1" {
+							key        = "user-1"
+							buckets    = 1
+							percentage = 101
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 0.000000 and 100.000000, got: 101.000000`),
+			},
+		},
+	})
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bitmaskMaxBits is the largest number of bits a bitmask may have, so that its result is never negative.
+const bitmaskMaxBits = 63
+
+var _ tfsdk.ResourceType = (*bitmaskResourceType)(nil)
+
+type bitmaskResourceType struct{}
+
+func (r *bitmaskResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_bitmask` generates a random integer of a given number of bits, " +
+			"interpreted as a bitmask, e.g. for permission-flag fixtures. Individual bits can be fixed to " +
+			"always be on or off.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"bits": {
+				Description: fmt.Sprintf("The number of bits in the bitmask, between 1 and %d, so that ", bitmaskMaxBits) +
+					"`result` always fits within a non-negative 64-bit integer.",
+				Type:     types.Int64Type,
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, bitmaskMaxBits),
+				},
+			},
+			"fixed_on": {
+				Description: "Set of bit positions that are always on, counted from `0` for the least " +
+					"significant bit. Every position must be smaller than `bits` and must not also be in " +
+					"`fixed_off`.",
+				Type: types.SetType{
+					ElemType: types.Int64Type,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					setvalidator.ValuesAre(int64validator.AtLeast(0)),
+				},
+			},
+			"fixed_off": {
+				Description: "Set of bit positions that are always off, counted from `0` for the least " +
+					"significant bit. Every position must be smaller than `bits` and must not also be in " +
+					"`fixed_on`.",
+				Type: types.SetType{
+					ElemType: types.Int64Type,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					setvalidator.ValuesAre(int64validator.AtLeast(0)),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile bitmasks.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same bitmask " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The bitmask as an integer.",
+				Type:        types.Int64Type,
+				Computed:    true,
+			},
+			"flags": {
+				Description: "The bits of `result`, in which the element at index `i` is `true` when bit " +
+					"`i` is on. The list has `bits` elements, starting with the least significant bit.",
+				Type: types.ListType{
+					ElemType: types.BoolType,
+				},
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *bitmaskResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &bitmaskResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*bitmaskResource)(nil)
+
+type bitmaskResource struct {
+	source *randSource
+}
+
+func (r *bitmaskResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan bitmaskModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bits := plan.Bits.Value
+
+	on, err := bitmaskFixed(plan.FixedOn, bits)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Bitmask Error",
+			fmt.Sprintf("The fixed_on value is invalid: %s.", err),
+		)
+		return
+	}
+
+	off, err := bitmaskFixed(plan.FixedOff, bits)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Bitmask Error",
+			fmt.Sprintf("The fixed_off value is invalid: %s.", err),
+		)
+		return
+	}
+
+	if on&off != 0 {
+		resp.Diagnostics.AddError(
+			"Create Random Bitmask Error",
+			"A bit position cannot be in both fixed_on and fixed_off.",
+		)
+		return
+	}
+
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
+	mask := int64(1)<<bits - 1
+	result := rand.Int63()&mask&^off | on
+
+	flags := make([]attr.Value, 0, bits)
+	for i := int64(0); i < bits; i++ {
+		flags = append(flags, types.Bool{Value: result&(1<<i) != 0})
+	}
+
+	b := bitmaskModelV0{
+		ID:       staticID(),
+		Keepers:  plan.Keepers,
+		Bits:     plan.Bits,
+		FixedOn:  plan.FixedOn,
+		FixedOff: plan.FixedOff,
+		Seed:     plan.Seed,
+		Salt:     plan.Salt,
+		Result:   types.Int64{Value: result},
+		Flags: types.List{
+			Elems:    flags,
+			ElemType: types.BoolType,
+		},
+	}
+
+	diags = resp.State.Set(ctx, b)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *bitmaskResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *bitmaskResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *bitmaskResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// bitmaskFixed returns the mask with every bit position in positions set. An error is returned when a position is
+// not smaller than bits.
+func bitmaskFixed(positions types.Set, bits int64) (int64, error) {
+	var mask int64
+
+	for _, v := range positions.Elems {
+		position := v.(types.Int64).Value
+		if position >= bits {
+			return 0, fmt.Errorf("the bit position %d needs to be smaller than bits (%d)", position, bits)
+		}
+
+		mask |= 1 << position
+	}
+
+	return mask, nil
+}
+
+type bitmaskModelV0 struct {
+	ID       types.String `tfsdk:"id"`
+	Keepers  types.Map    `tfsdk:"keepers"`
+	Bits     types.Int64  `tfsdk:"bits"`
+	FixedOn  types.Set    `tfsdk:"fixed_on"`
+	FixedOff types.Set    `tfsdk:"fixed_off"`
+	Seed     types.String `tfsdk:"seed"`
+	Salt     types.String `tfsdk:"salt"`
+	Result   types.Int64  `tfsdk:"result"`
+	Flags    types.List   `tfsdk:"flags"`
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceBitmask(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bitmask" "bitmask" {
+							bits = 8
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_bitmask.bitmask", "flags.#", "8"),
+					resource.TestCheckResourceAttrWith("random_bitmask.bitmask", "result", testCheckInt64Between(0, 255)),
+					testAccResourceBitmaskCheckFlags("random_bitmask.bitmask"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBitmask_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bitmask" "bitmask" {
+							bits = 8
+							seed = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_bitmask.bitmask", "result", "26"),
+					testAccResourceBitmaskCheckFlags("random_bitmask.bitmask"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBitmask_Fixed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bitmask" "all_fixed" {
+							bits      = 4
+							fixed_on  = [0, 2]
+							fixed_off = [1, 3]
+						}
+						resource "random_bitmask" "max_bits" {
+							bits     = 63
+							fixed_on = [62]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_bitmask.all_fixed", "result", "5"),
+					resource.TestCheckResourceAttr("random_bitmask.all_fixed", "flags.0", "true"),
+					resource.TestCheckResourceAttr("random_bitmask.all_fixed", "flags.1", "false"),
+					resource.TestCheckResourceAttr("random_bitmask.all_fixed", "flags.2", "true"),
+					resource.TestCheckResourceAttr("random_bitmask.all_fixed", "flags.3", "false"),
+					resource.TestCheckResourceAttr("random_bitmask.max_bits", "flags.62", "true"),
+					testAccResourceBitmaskCheckFlags("random_bitmask.max_bits"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBitmask_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bitmask" "bitmask" {
+							bits = 64
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 1 and 63, got: 64`),
+			},
+			{
+				Config: `resource "random_bitmask" "bitmask" {
+							bits     = 8
+							fixed_on = [8]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The fixed_on value is invalid: the bit position 8 needs to be smaller than\nbits \(8\).`),
+			},
+			{
+				Config: `resource "random_bitmask" "bitmask" {
+							bits      = 8
+							fixed_off = [-1]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 0, got: -1`),
+			},
+			{
+				Config: `resource "random_bitmask" "bitmask" {
+							bits      = 8
+							fixed_on  = [1, 2]
+							fixed_off = [2, 3]
+						}`,
+				ExpectError: regexp.MustCompile(`.*A bit position cannot be in both fixed_on and fixed_off.`),
+			},
+		},
+	})
+}
+
+// testAccResourceBitmaskCheckFlags checks that every element of flags matches the corresponding bit of result.
+func testAccResourceBitmaskCheckFlags(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		result, err := strconv.ParseInt(rs.Primary.Attributes["result"], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		bits, err := strconv.Atoi(rs.Primary.Attributes["bits"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < bits; i++ {
+			expected := strconv.FormatBool(result&(1<<i) != 0)
+			if actual := rs.Primary.Attributes[fmt.Sprintf("flags.%d", i)]; actual != expected {
+				return fmt.Errorf("expected flags.%d to be %s for result %d, got %s", i, expected, result, actual)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var _ tfsdk.ResourceType = (*bytesResourceType)(nil)
+
+type bytesResourceType struct{}
+
+func (r *bytesResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_bytes` generates random bytes that are intended to be used as a " +
+			"secret, or key. Use this in preference to `random_id` when the output is considered sensitive, " +
+			"and should not be displayed in the CLI.\n" +
+			"\n" +
+			"This resource *does* use a cryptographic random number generator, unless `seed` is set.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"regenerate_on": regenerateOnAttribute(),
+			"nonce":         nonceAttribute(),
+			"length": {
+				Description: "The number of bytes requested. The minimum value for length is 1. Exactly one of " +
+					"`length` or `bit_length` must be set. When `bit_length` is set, this is the number of " +
+					"bytes needed to hold `bit_length` bits.",
+				Type:     types.Int64Type,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"bit_length": {
+				Description: "The number of bits requested, e.g. for key sizes that are specified in bits. The " +
+					"bits are rounded up to whole bytes, and the unused high bits of the first byte are set to " +
+					"zero. The minimum value for bit_length is 1. Exactly one of `length` or `bit_length` must be " +
+					"set. When `length` is set, this is `8 * length`.",
+				Type:     types.Int64Type,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+					schemavalidator.ExactlyOneOf(path.MatchRoot("length")),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed a deterministic, non-cryptographic random " +
+					"number generator, in order to produce reproducible bytes, e.g. for test fixtures.\n" +
+					"\n" +
+					"**Important:** Anyone who knows the seed can reproduce the bytes, so seeded output must " +
+					"not be used as a secret or key. Even with an identical seed, it is not guaranteed that " +
+					"the same bytes will be produced across different versions of Terraform. A warning is " +
+					"shown whenever `seed` is set, unless `acknowledge_insecure_seed` is `true`.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"acknowledge_insecure_seed": {
+				Description: "Set to `true` to silence the warning shown when `seed` is set, confirming that " +
+					"the bytes are not used as a secret or key. Changing this value does not regenerate the " +
+					"bytes.",
+				Type:     types.BoolType,
+				Optional: true,
+			},
+			"base64": {
+				Description: "The generated bytes presented in base64 string format.",
+				Type:        types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"base64url": {
+				Description: "The generated bytes presented in unpadded base64 string format, using the " +
+					"URL-friendly character set: case-sensitive letters, digits and the characters `_` and `-`.",
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"hex": {
+				Description: "The generated bytes presented in lowercase hexadecimal string format. The " +
+					"length of the encoded string is exactly twice the `length` parameter.",
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r *bytesResourceType) NewResource(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &bytesResource{}, nil
+}
+
+var (
+	_ tfsdk.Resource                   = (*bytesResource)(nil)
+	_ tfsdk.ResourceWithImportState    = (*bytesResource)(nil)
+	_ tfsdk.ResourceWithValidateConfig = (*bytesResource)(nil)
+)
+
+type bytesResource struct{}
+
+// ValidateConfig warns when seed is set, as seeded bytes are generated by a non-cryptographic random number
+// generator. The warning is not an error so that existing configurations using seed keep working.
+func (r *bytesResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config bytesModelV0
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Seed.Null || config.AcknowledgeInsecureSeed.Unknown || config.AcknowledgeInsecureSeed.Value {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("seed"),
+		"Insecure Random Bytes Seed",
+		"When seed is set, random_bytes uses a deterministic, non-cryptographic random number generator. "+
+			"Anyone who knows the seed can reproduce the bytes, so they must not be used as a secret or key.\n\n"+
+			"Remove seed to generate the bytes with a cryptographic random number generator, or set "+
+			"acknowledge_insecure_seed to true to silence this warning.",
+	)
+}
+
+func (r *bytesResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan bytesModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length, bitLength := plan.Length.Value, 8*plan.Length.Value
+	if !plan.BitLength.Null && !plan.BitLength.Unknown {
+		length, bitLength = (plan.BitLength.Value+7)/8, plan.BitLength.Value
+	}
+
+	bytes := make([]byte, length)
+
+	if plan.Seed.Null {
+		n, err := rand.Reader.Read(bytes)
+		if int64(n) != length {
+			resp.Diagnostics.Append(diagnostics.RandomnessGenerationError(err.Error())...)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+			return
+		}
+	} else {
+		// Read on a math/rand.Rand always fills the slice and never returns an error.
+		_, _ = random.NewSaltedRand(plan.Seed.Value, plan.Salt.Value).Read(bytes)
+	}
+
+	// The bytes are big-endian, so the bits beyond bitLength are the high bits of the first byte.
+	if rest := bitLength % 8; rest != 0 {
+		bytes[0] &= byte(1)<<rest - 1
+	}
+
+	b := bytesModelV0{
+		ID:                      staticID(),
+		Keepers:                 plan.Keepers,
+		RegenerateOn:            plan.RegenerateOn,
+		Nonce:                   plan.Nonce,
+		Length:                  types.Int64{Value: length},
+		BitLength:               types.Int64{Value: bitLength},
+		Seed:                    plan.Seed,
+		Salt:                    plan.Salt,
+		AcknowledgeInsecureSeed: plan.AcknowledgeInsecureSeed,
+		Base64:                  types.String{Value: base64.StdEncoding.EncodeToString(bytes)},
+		Base64URL:               types.String{Value: base64.RawURLEncoding.EncodeToString(bytes)},
+		Hex:                     types.String{Value: hex.EncodeToString(bytes)},
+	}
+
+	diags = resp.State.Set(ctx, b)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *bytesResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update only stores acknowledge_insecure_seed, as all other required and optional attributes force replacement
+// of the resource through the RequiresReplace AttributePlanModifier.
+func (r *bytesResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state bytesModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.AcknowledgeInsecureSeed = plan.AcknowledgeInsecureSeed
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *bytesResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+func (r *bytesResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	bytes, err := base64.StdEncoding.DecodeString(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Random Bytes Error",
+			"While attempting to import random bytes there was a decoding error. The import ID needs to "+
+				"be the bytes presented in base64 string format.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	var state bytesModelV0
+
+	state.ID = staticID()
+	state.Keepers.ElemType = types.StringType
+	state.RegenerateOn = types.Set{ElemType: types.StringType, Null: true}
+	state.Nonce.Null = true
+	state.Length.Value = int64(len(bytes))
+	state.BitLength.Value = 8 * int64(len(bytes))
+	state.Seed.Null = true
+	state.Salt.Null = true
+	state.AcknowledgeInsecureSeed.Null = true
+	state.Base64.Value = req.ID
+	state.Base64URL.Value = base64.RawURLEncoding.EncodeToString(bytes)
+	state.Hex.Value = hex.EncodeToString(bytes)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type bytesModelV0 struct {
+	ID                      types.String `tfsdk:"id"`
+	Keepers                 types.Map    `tfsdk:"keepers"`
+	RegenerateOn            types.Set    `tfsdk:"regenerate_on"`
+	Nonce                   types.Int64  `tfsdk:"nonce"`
+	Length                  types.Int64  `tfsdk:"length"`
+	BitLength               types.Int64  `tfsdk:"bit_length"`
+	Seed                    types.String `tfsdk:"seed"`
+	Salt                    types.String `tfsdk:"salt"`
+	AcknowledgeInsecureSeed types.Bool   `tfsdk:"acknowledge_insecure_seed"`
+	Base64                  types.String `tfsdk:"base64"`
+	Base64URL               types.String `tfsdk:"base64url"`
+	Hex                     types.String `tfsdk:"hex"`
+}
@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceBytes(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "basic" {
+							length = 32
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_bytes.basic", "base64", testCheckLen(44)),
+					resource.TestCheckResourceAttrWith("random_bytes.basic", "base64url", testCheckLen(43)),
+					resource.TestCheckResourceAttrWith("random_bytes.basic", "hex", testCheckLen(64)),
+					resource.TestCheckResourceAttr("random_bytes.basic", "length", "32"),
+					testAccResourceBytesCheckEncodingsMatch("random_bytes.basic"),
+				),
+			},
+			{
+				ResourceName:      "random_bytes.basic",
+				ImportState:       true,
+				ImportStateIdFunc: testAccResourceBytesImportStateIDFunc("random_bytes.basic"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "seeded" {
+							count  = 2
+							length = 8
+							seed   = "12345"
+						}
+						resource "random_bytes" "unseeded" {
+							count  = 2
+							length = 8
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_bytes.seeded.0", "hex", "1ae969564b34a33e"),
+					resource.TestCheckResourceAttr("random_bytes.seeded.1", "hex", "1ae969564b34a33e"),
+					resource.TestCheckResourceAttr("random_bytes.seeded.0", "base64", "GulpVks0oz4="),
+					resource.TestCheckResourceAttr("random_bytes.seeded.0", "base64url", "GulpVks0oz4"),
+					testAccCheckDistinctResults("random_bytes.unseeded", 2, "hex"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_LengthErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "invalid_length" {
+							length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_BitLength(t *testing.T) {
+	checks := []resource.TestCheckFunc{
+		// The seeded bytes match those of length = 8, with the four high bits of the first byte set to zero.
+		resource.TestCheckResourceAttr("random_bytes.seeded", "hex", "0ae969564b34a33e"),
+		resource.TestCheckResourceAttr("random_bytes.seeded", "length", "8"),
+		resource.TestCheckResourceAttr("random_bytes.seeded", "bit_length", "60"),
+		resource.TestCheckResourceAttr("random_bytes.bytes", "bit_length", "32"),
+	}
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("random_bytes.bits.%d", i)
+		checks = append(checks,
+			resource.TestMatchResourceAttr(name, "hex", regexp.MustCompile(`^[01][0-9a-f]{3}$`)),
+			resource.TestCheckResourceAttr(name, "length", "2"),
+			resource.TestCheckResourceAttr(name, "bit_length", "13"),
+		)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "seeded" {
+							bit_length                = 60
+							seed                      = "12345"
+							acknowledge_insecure_seed = true
+						}
+						resource "random_bytes" "bits" {
+							count      = 10
+							bit_length = 13
+						}
+						resource "random_bytes" "bytes" {
+							length = 4
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_BitLengthErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "both" {
+							length     = 2
+							bit_length = 16
+						}`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination`),
+			},
+			{
+				Config:      `resource "random_bytes" "neither" {}`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "random_bytes" "invalid_bit_length" {
+							bit_length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_AcknowledgeInsecureSeed(t *testing.T) {
+	var base64Value string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "basic" {
+							length = 16
+						}`,
+				Check: testAccCheckAttrCapture("random_bytes.basic", "base64", &base64Value),
+			},
+			{
+				Config: `resource "random_bytes" "basic" {
+							length                    = 16
+							acknowledge_insecure_seed = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_bytes.basic", "acknowledge_insecure_seed", "true"),
+					testAccCheckAttrEquals("random_bytes.basic", "base64", &base64Value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_AcknowledgeInsecureSeedKeepsBytes(t *testing.T) {
+	var hexValue, dependent string
+
+	config := func(acknowledge bool) string {
+		return fmt.Sprintf(`resource "random_bytes" "seeded" {
+								bit_length                = 60
+								seed                      = "12345"
+								acknowledge_insecure_seed = %t
+							}
+							resource "random_string" "dependent" {
+								length  = 12
+								keepers = {
+									hex = random_bytes.seeded.hex
+								}
+							}`, acknowledge)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttrCapture("random_bytes.seeded", "hex", &hexValue),
+					testAccCheckAttrCapture("random_string.dependent", "result", &dependent),
+				),
+			},
+			{
+				Config: config(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_bytes.seeded", "acknowledge_insecure_seed", "false"),
+					resource.TestCheckResourceAttr("random_bytes.seeded", "length", "8"),
+					testAccCheckAttrEquals("random_bytes.seeded", "hex", &hexValue),
+					testAccCheckAttrEquals("random_string.dependent", "result", &dependent),
+				),
+			},
+		},
+	})
+}
+
+func TestBytesResource_ValidateConfigSeedWarning(t *testing.T) {
+	testCases := map[string]struct {
+		seed            tftypes.Value
+		acknowledge     tftypes.Value
+		expectedWarning bool
+	}{
+		"no seed": {
+			seed:        tftypes.NewValue(tftypes.String, nil),
+			acknowledge: tftypes.NewValue(tftypes.Bool, nil),
+		},
+		"seed": {
+			seed:            tftypes.NewValue(tftypes.String, "12345"),
+			acknowledge:     tftypes.NewValue(tftypes.Bool, nil),
+			expectedWarning: true,
+		},
+		"seed not acknowledged": {
+			seed:            tftypes.NewValue(tftypes.String, "12345"),
+			acknowledge:     tftypes.NewValue(tftypes.Bool, false),
+			expectedWarning: true,
+		},
+		"seed acknowledged": {
+			seed:        tftypes.NewValue(tftypes.String, "12345"),
+			acknowledge: tftypes.NewValue(tftypes.Bool, true),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			schema, diags := (&bytesResourceType{}).GetSchema(ctx)
+			if diags.HasError() {
+				t.Fatalf("error getting schema: %v", diags)
+			}
+
+			values := make(map[string]tftypes.Value, len(schema.Attributes))
+			for k, a := range schema.Attributes {
+				values[k] = tftypes.NewValue(a.Type.TerraformType(ctx), nil)
+			}
+			values["length"] = tftypes.NewValue(tftypes.Number, 16)
+			values["seed"] = testCase.seed
+			values["acknowledge_insecure_seed"] = testCase.acknowledge
+
+			req := tfsdk.ValidateResourceConfigRequest{
+				Config: tfsdk.Config{
+					Schema: schema,
+					Raw:    tftypes.NewValue(schema.TerraformType(ctx), values),
+				},
+			}
+			resp := &tfsdk.ValidateResourceConfigResponse{}
+
+			(&bytesResource{}).ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			warnings := resp.Diagnostics.Warnings()
+			if testCase.expectedWarning && (len(warnings) != 1 || warnings[0].Summary() != "Insecure Random Bytes Seed") {
+				t.Errorf("expected an insecure seed warning, got %v", warnings)
+			}
+			if !testCase.expectedWarning && len(warnings) != 0 {
+				t.Errorf("expected no warnings, got %v", warnings)
+			}
+		})
+	}
+}
+
+func testAccResourceBytesImportStateIDFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+
+		return rs.Primary.Attributes["base64"], nil
+	}
+}
+
+// testAccResourceBytesCheckEncodingsMatch checks that the base64, base64url and hex attributes of name all decode to
+// the same bytes.
+func testAccResourceBytesCheckEncodingsMatch(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		std, err := base64.StdEncoding.DecodeString(rs.Primary.Attributes["base64"])
+		if err != nil {
+			return fmt.Errorf("decoding base64: %w", err)
+		}
+
+		url, err := base64.RawURLEncoding.DecodeString(rs.Primary.Attributes["base64url"])
+		if err != nil {
+			return fmt.Errorf("decoding base64url: %w", err)
+		}
+
+		hexBytes, err := hex.DecodeString(rs.Primary.Attributes["hex"])
+		if err != nil {
+			return fmt.Errorf("decoding hex: %w", err)
+		}
+
+		if !bytes.Equal(std, url) || !bytes.Equal(std, hexBytes) {
+			return fmt.Errorf("expected all encodings to decode to the same bytes, got %x, %x and %x", std, url, hexBytes)
+		}
+
+		return nil
+	}
+}
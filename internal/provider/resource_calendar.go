@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+)
+
+// calendarDays lists the weekdays in the order in which they are drawn, so that the same seed always produces the
+// same weekday regardless of the order of days.
+var calendarDays = stringEnum{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// calendarTimeLayout is the layout of business_start, business_end and time.
+const calendarTimeLayout = "15:04"
+
+var _ tfsdk.ResourceType = (*calendarResourceType)(nil)
+
+type calendarResourceType struct{}
+
+func (r *calendarResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_calendar` generates a random weekday and time of day within business " +
+			"hours, e.g. for scheduling fixtures.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"business_start": {
+				Description: "The earliest time of day that can be drawn, in the form `HH:MM` on a 24-hour " +
+					"clock. Must be earlier than `business_end`. Default value is `09:00`.",
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.String{Value: "09:00"}),
+					planmodifiers.RequiresReplace(),
+				},
+			},
+			"business_end": {
+				Description: "The end of business hours, in the form `HH:MM` on a 24-hour clock. Times are " +
+					"drawn up to, but not including, this time. Default value is `17:00`.",
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.String{Value: "17:00"}),
+					planmodifiers.RequiresReplace(),
+				},
+			},
+			"days": {
+				Description: "The set of weekdays that can be drawn. " + calendarDays.Description() +
+					" Default value is `monday` to `friday`.",
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Set{
+						ElemType: types.StringType,
+						Elems: []attr.Value{
+							types.String{Value: "monday"},
+							types.String{Value: "tuesday"},
+							types.String{Value: "wednesday"},
+							types.String{Value: "thursday"},
+							types.String{Value: "friday"},
+						},
+					}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValuesAre(calendarDays.Validator()),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile results.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same result " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"weekday": {
+				Description: "The drawn weekday, one of `days`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"time": {
+				Description: "The drawn time of day, in the form `HH:MM` on a 24-hour clock.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"result": {
+				Description: "The drawn weekday and time of day, separated by a space, e.g. `tuesday 14:23`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *calendarResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &calendarResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*calendarResource)(nil)
+
+type calendarResource struct {
+	source *randSource
+}
+
+func (r *calendarResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan calendarModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, err := calendarMinutes(plan.BusinessStart.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Calendar Error",
+			fmt.Sprintf("The business_start value %q needs to be a time of day in the form HH:MM, e.g. 09:00.", plan.BusinessStart.Value),
+		)
+		return
+	}
+
+	end, err := calendarMinutes(plan.BusinessEnd.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Calendar Error",
+			fmt.Sprintf("The business_end value %q needs to be a time of day in the form HH:MM, e.g. 17:00.", plan.BusinessEnd.Value),
+		)
+		return
+	}
+
+	if start >= end {
+		resp.Diagnostics.AddError(
+			"Create Random Calendar Error",
+			fmt.Sprintf("The business_start value (%s) needs to be earlier than the business_end value (%s).",
+				plan.BusinessStart.Value, plan.BusinessEnd.Value),
+		)
+		return
+	}
+
+	allowed := make(map[string]struct{}, len(plan.Days.Elems))
+	for _, v := range plan.Days.Elems {
+		allowed[v.(types.String).Value] = struct{}{}
+	}
+
+	days := make([]string, 0, len(allowed))
+	for _, day := range calendarDays {
+		if _, ok := allowed[day]; ok {
+			days = append(days, day)
+		}
+	}
+
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
+	weekday := days[rand.Intn(len(days))]
+	minutes := start + rand.Intn(end-start)
+	t := fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+
+	c := calendarModelV0{
+		ID:            staticID(),
+		Keepers:       plan.Keepers,
+		BusinessStart: plan.BusinessStart,
+		BusinessEnd:   plan.BusinessEnd,
+		Days:          plan.Days,
+		Seed:          plan.Seed,
+		Salt:          plan.Salt,
+		Weekday:       types.String{Value: weekday},
+		Time:          types.String{Value: t},
+		Result:        types.String{Value: weekday + " " + t},
+	}
+
+	diags = resp.State.Set(ctx, c)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *calendarResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *calendarResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *calendarResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// calendarMinutes returns the number of minutes since midnight of a time of day in the form HH:MM.
+func calendarMinutes(value string) (int, error) {
+	t, err := time.Parse(calendarTimeLayout, value)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+type calendarModelV0 struct {
+	ID            types.String `tfsdk:"id"`
+	Keepers       types.Map    `tfsdk:"keepers"`
+	BusinessStart types.String `tfsdk:"business_start"`
+	BusinessEnd   types.String `tfsdk:"business_end"`
+	Days          types.Set    `tfsdk:"days"`
+	Seed          types.String `tfsdk:"seed"`
+	Salt          types.String `tfsdk:"salt"`
+	Weekday       types.String `tfsdk:"weekday"`
+	Time          types.String `tfsdk:"time"`
+	Result        types.String `tfsdk:"result"`
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceCalendar(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_calendar" "calendar" {
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_calendar.calendar", "business_start", "09:00"),
+					resource.TestCheckResourceAttr("random_calendar.calendar", "business_end", "17:00"),
+					resource.TestCheckResourceAttr("random_calendar.calendar", "days.#", "5"),
+					resource.TestMatchResourceAttr("random_calendar.calendar", "weekday", regexp.MustCompile(`^(monday|tuesday|wednesday|thursday|friday)$`)),
+					resource.TestMatchResourceAttr("random_calendar.calendar", "time", regexp.MustCompile(`^(09|1[0-6]):[0-5][0-9]$`)),
+					resource.TestMatchResourceAttr("random_calendar.calendar", "result", regexp.MustCompile(`^[a-z]+ [0-9]{2}:[0-9]{2}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCalendar_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_calendar" "calendar" {
+							seed = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_calendar.calendar", "result", "thursday 12:43"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCalendar_Windows(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_calendar" "single" {
+							business_start = "23:59"
+							business_end   = "23:59"
+							days           = ["sunday"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The business_start value \(23:59\) needs to be earlier than the business_end\nvalue \(23:59\).`),
+			},
+			{
+				Config: `resource "random_calendar" "single" {
+							business_start = "23:58"
+							business_end   = "23:59"
+							days           = ["sunday"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_calendar.single", "result", "sunday 23:58"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCalendar_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_calendar" "calendar" {
+							business_start = "9am"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The business_start value "9am" needs to be a time of day in the form HH:MM`),
+			},
+			{
+				Config: `resource "random_calendar" "calendar" {
+							business_end = "25:00"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The business_end value "25:00" needs to be a time of day in the form HH:MM`),
+			},
+			{
+				Config: `resource "random_calendar" "calendar" {
+							business_start = "17:00"
+							business_end   = "09:00"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The business_start value \(17:00\) needs to be earlier than the business_end`),
+			},
+			{
+				Config: `resource "random_calendar" "calendar" {
+							days = ["funday"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be one of:`),
+			},
+			{
+				Config: `resource "random_calendar" "calendar" {
+							days = []
+						}`,
+				ExpectError: regexp.MustCompile(`.*Set must contain at least 1 elements, got: 0`),
+			},
+		},
+	})
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+)
+
+const (
+	// couponChars is the Crockford base32 alphabet, which omits the easily confused letters I, L, O and U.
+	couponChars = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+	// couponVowels are the vowels that remain in couponChars.
+	couponVowels = "AE"
+)
+
+var _ tfsdk.ResourceType = (*couponResourceType)(nil)
+
+type couponResourceType struct{}
+
+func (r *couponResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_coupon` generates human-friendly, uppercase codes suitable for " +
+			"coupons and vouchers. Codes are drawn from a Crockford base32 alphabet, which omits the easily " +
+			"confused letters `I`, `L`, `O` and `U`, and can optionally be split into segments.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator and should not be used " +
+			"for secrets.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"length": {
+				Description: "The number of characters in the code, not counting segment separators. Must be " +
+					"a multiple of `segments`.",
+				Type:     types.Int64Type,
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"segments": {
+				Description: "The number of equally sized segments the code is split into. Default value is `1`.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Int64{Value: 1}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"segment_separator": {
+				Description: "The character(s) placed between segments. Default value is `-`.",
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.String{Value: "-"}),
+					planmodifiers.RequiresReplace(),
+				},
+			},
+			"exclude_vowels": {
+				Description: "Exclude the vowels `A` and `E` from the code, to avoid accidentally spelling " +
+					"words. Default value is `true`.",
+				Type:     types.BoolType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Bool{Value: true}),
+					planmodifiers.RequiresReplace(),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile codes.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same code " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The generated coupon code.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *couponResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &couponResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*couponResource)(nil)
+
+type couponResource struct {
+	source *randSource
+}
+
+func (r *couponResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan couponModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := plan.Length.Value
+	segments := plan.Segments.Value
+
+	if length%segments != 0 {
+		resp.Diagnostics.AddError(
+			"Create Random Coupon Error",
+			"The length needs to be a multiple of the number of segments, so that every segment has the same length.",
+		)
+		return
+	}
+
+	chars := couponChars
+	if plan.ExcludeVowels.Value {
+		chars = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(couponVowels, r) {
+				return -1
+			}
+			return r
+		}, chars)
+	}
+
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
+	segmentLength := length / segments
+	parts := make([]string, segments)
+
+	for i := range parts {
+		segment := make([]byte, segmentLength)
+		for j := range segment {
+			segment[j] = chars[rand.Intn(len(chars))]
+		}
+		parts[i] = string(segment)
+	}
+
+	c := couponModelV0{
+		ID:               staticID(),
+		Keepers:          plan.Keepers,
+		Length:           plan.Length,
+		Segments:         plan.Segments,
+		SegmentSeparator: plan.SegmentSeparator,
+		ExcludeVowels:    plan.ExcludeVowels,
+		Seed:             plan.Seed,
+		Salt:             plan.Salt,
+		Result:           types.String{Value: strings.Join(parts, plan.SegmentSeparator.Value)},
+	}
+
+	diags = resp.State.Set(ctx, c)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *couponResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *couponResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *couponResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+type couponModelV0 struct {
+	ID               types.String `tfsdk:"id"`
+	Keepers          types.Map    `tfsdk:"keepers"`
+	Length           types.Int64  `tfsdk:"length"`
+	Segments         types.Int64  `tfsdk:"segments"`
+	SegmentSeparator types.String `tfsdk:"segment_separator"`
+	ExcludeVowels    types.Bool   `tfsdk:"exclude_vowels"`
+	Seed             types.String `tfsdk:"seed"`
+	Salt             types.String `tfsdk:"salt"`
+	Result           types.String `tfsdk:"result"`
+}
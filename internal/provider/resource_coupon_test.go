@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceCoupon(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_coupon" "coupon" {
+							length = 8
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_coupon.coupon", "result", regexp.MustCompile(`^[0-9BCDFGHJKMNPQRSTVWXYZ]{8}$`)),
+					resource.TestCheckResourceAttr("random_coupon.coupon", "segments", "1"),
+					resource.TestCheckResourceAttr("random_coupon.coupon", "segment_separator", "-"),
+					resource.TestCheckResourceAttr("random_coupon.coupon", "exclude_vowels", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCoupon_Segments(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_coupon" "coupon" {
+							length            = 12
+							segments          = 3
+							segment_separator = "_"
+							exclude_vowels    = false
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_coupon.coupon", "result", regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{4}_[0-9A-HJKMNP-TV-Z]{4}_[0-9A-HJKMNP-TV-Z]{4}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCoupon_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_coupon" "coupon" {
+							length   = 8
+							segments = 2
+							seed     = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_coupon.coupon", "result", "SF4J-C5D6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCoupon_SegmentErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_coupon" "coupon" {
+							length   = 10
+							segments = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*The length needs to be a multiple of the number of segments`),
+			},
+			{
+				Config: `resource "random_coupon" "coupon" {
+							length   = 10
+							segments = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
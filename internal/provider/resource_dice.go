@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// diceMaxCount is the maximum number of dice a dice expression may roll.
+const diceMaxCount = 1000
+
+// dicePattern matches a dice expression of the form NdM+K, in which the count N and the modifier K are optional.
+var dicePattern = regexp.MustCompile(`^([0-9]*)[dD]([0-9]+)([+-][0-9]+)?$`)
+
+var _ tfsdk.ResourceType = (*diceResourceType)(nil)
+
+type diceResourceType struct{}
+
+func (r *diceResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_dice` rolls dice given in the `NdM+K` notation of tabletop games, " +
+			"e.g. `3d6+2` rolls three six-sided dice and adds two to their sum.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"dice": {
+				Description: "The dice to roll, of the form `NdM+K` or `NdM-K`: `N` dice with `M` sides each, " +
+					"numbered `1` to `M`, and a modifier `K` that is added to or subtracted from their sum. " +
+					"`N` is between 1 and " + strconv.Itoa(diceMaxCount) + ", and defaults to 1 when omitted, " +
+					"e.g. `d20`. `M` is between 1 and " + strconv.Itoa(math.MaxInt32) + ". The modifier is " +
+					"optional, e.g. `2d8`, and at most " + strconv.Itoa(math.MaxInt32) + " in magnitude.",
+				Type:     types.StringType,
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.RegexMatches(
+						dicePattern,
+						"value must be a dice expression of the form NdM+K, e.g. 3d6+2, d20 or 2d8",
+					),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile rolls.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same rolls " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The sum of `rolls` and the modifier.",
+				Type:        types.Int64Type,
+				Computed:    true,
+			},
+			"rolls": {
+				Description: "The value rolled by each die, in the order they were rolled.",
+				Type: types.ListType{
+					ElemType: types.Int64Type,
+				},
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *diceResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &diceResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*diceResource)(nil)
+
+type diceResource struct {
+	source *randSource
+}
+
+func (r *diceResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan diceModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	count, sides, modifier, err := diceParse(plan.Dice.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Dice Error",
+			fmt.Sprintf("The dice value is invalid: %s.", err),
+		)
+		return
+	}
+
+	rolls := diceRoll(r.source.newRand(plan.Seed.Value, plan.Salt.Value), count, sides)
+
+	// The bounds of diceParse keep the sum well within int64.
+	sum := modifier
+	elems := make([]attr.Value, 0, len(rolls))
+	for _, roll := range rolls {
+		sum += roll
+		elems = append(elems, types.Int64{Value: roll})
+	}
+
+	d := diceModelV0{
+		ID:      staticID(),
+		Keepers: plan.Keepers,
+		Dice:    plan.Dice,
+		Seed:    plan.Seed,
+		Salt:    plan.Salt,
+		Result:  types.Int64{Value: sum},
+		Rolls: types.List{
+			Elems:    elems,
+			ElemType: types.Int64Type,
+		},
+	}
+
+	diags = resp.State.Set(ctx, d)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *diceResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *diceResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *diceResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// diceParse returns the number of dice, the number of sides of each die and the modifier of the dice expression.
+// An error is returned when the expression is malformed, or its count, sides or modifier are out of bounds.
+func diceParse(expression string) (count, sides, modifier int64, err error) {
+	m := dicePattern.FindStringSubmatch(expression)
+	if m == nil {
+		return 0, 0, 0, fmt.Errorf("%q needs to be of the form NdM+K", expression)
+	}
+
+	count = 1
+	if m[1] != "" {
+		count, err = strconv.ParseInt(m[1], 10, 64)
+		if err != nil || count < 1 || count > diceMaxCount {
+			return 0, 0, 0, fmt.Errorf("the number of dice in %q needs to be between 1 and %d", expression, diceMaxCount)
+		}
+	}
+
+	sides, err = strconv.ParseInt(m[2], 10, 64)
+	if err != nil || sides < 1 || sides > math.MaxInt32 {
+		return 0, 0, 0, fmt.Errorf("the number of sides in %q needs to be between 1 and %d", expression, math.MaxInt32)
+	}
+
+	if m[3] != "" {
+		modifier, err = strconv.ParseInt(m[3], 10, 64)
+		if err != nil || modifier < -math.MaxInt32 || modifier > math.MaxInt32 {
+			return 0, 0, 0, fmt.Errorf("the modifier in %q needs to be at most %d in magnitude", expression, math.MaxInt32)
+		}
+	}
+
+	return count, sides, modifier, nil
+}
+
+// diceRoll returns count values drawn from rand, each between 1 and sides inclusive.
+func diceRoll(rand *rand.Rand, count, sides int64) []int64 {
+	rolls := make([]int64, 0, count)
+
+	for i := int64(0); i < count; i++ {
+		rolls = append(rolls, 1+rand.Int63n(sides))
+	}
+
+	return rolls
+}
+
+type diceModelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Keepers types.Map    `tfsdk:"keepers"`
+	Dice    types.String `tfsdk:"dice"`
+	Seed    types.String `tfsdk:"seed"`
+	Salt    types.String `tfsdk:"salt"`
+	Result  types.Int64  `tfsdk:"result"`
+	Rolls   types.List   `tfsdk:"rolls"`
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceDice(t *testing.T) {
+	checks := make([]resource.TestCheckFunc, 0, 10)
+	for i := 0; i < 10; i++ {
+		checks = append(checks, testAccResourceDiceCheckRolls(fmt.Sprintf("random_dice.dice.%d", i), 4, 6, -1))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_dice" "dice" {
+							count = 10
+							dice  = "4d6-1"
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourceDice_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_dice" "dice" {
+							dice = "3d6+2"
+							seed = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_dice.dice", "rolls.0", "3"),
+					resource.TestCheckResourceAttr("random_dice.dice", "rolls.1", "1"),
+					resource.TestCheckResourceAttr("random_dice.dice", "rolls.2", "4"),
+					resource.TestCheckResourceAttr("random_dice.dice", "result", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDice_Defaults(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_dice" "d20" {
+							dice = "d20"
+						}
+						resource "random_dice" "coin" {
+							dice = "1D1+5"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceDiceCheckRolls("random_dice.d20", 1, 20, 0),
+					resource.TestCheckResourceAttr("random_dice.coin", "rolls.#", "1"),
+					resource.TestCheckResourceAttr("random_dice.coin", "result", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceDice_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_dice" "dice" {
+							dice = "3x6"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be a dice expression of the form NdM\+K, e.g. 3d6\+2, d20 or 2d8`),
+			},
+			{
+				Config: `resource "random_dice" "dice" {
+							dice = "0d6"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The dice value is invalid: the number of dice in "0d6" needs to be between 1\nand 1000.`),
+			},
+			{
+				Config: `resource "random_dice" "dice" {
+							dice = "2d0"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The dice value is invalid: the number of sides in "2d0" needs to be between 1\nand 2147483647.`),
+			},
+		},
+	})
+}
+
+func TestDiceParse(t *testing.T) {
+	testCases := map[string]struct {
+		expression                   string
+		expectedCount, expectedSides int64
+		expectedModifier             int64
+		expectedError                bool
+	}{
+		"count sides modifier": {expression: "3d6+2", expectedCount: 3, expectedSides: 6, expectedModifier: 2},
+		"negative modifier":    {expression: "2d8-3", expectedCount: 2, expectedSides: 8, expectedModifier: -3},
+		"no count":             {expression: "d20", expectedCount: 1, expectedSides: 20},
+		"upper case":           {expression: "10D100", expectedCount: 10, expectedSides: 100},
+		"too many dice":        {expression: "1001d6", expectedError: true},
+		"too many sides":       {expression: "1d2147483648", expectedError: true},
+		"modifier too large":   {expression: "1d6+2147483648", expectedError: true},
+		"malformed":            {expression: "3d6+", expectedError: true},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			count, sides, modifier, err := diceParse(testCase.expression)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatalf("expected an error, got count %d, sides %d and modifier %d", count, sides, modifier)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if count != testCase.expectedCount || sides != testCase.expectedSides || modifier != testCase.expectedModifier {
+				t.Errorf("expected count %d, sides %d and modifier %d, got %d, %d and %d", testCase.expectedCount,
+					testCase.expectedSides, testCase.expectedModifier, count, sides, modifier)
+			}
+		})
+	}
+}
+
+// testAccResourceDiceCheckRolls checks that the resource rolled count dice between 1 and sides, and that its result
+// is the sum of the rolls and modifier.
+func testAccResourceDiceCheckRolls(name string, count, sides, modifier int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		attrs := rs.Primary.Attributes
+
+		if got := attrs["rolls.#"]; got != strconv.FormatInt(count, 10) {
+			return fmt.Errorf("expected %d rolls, got %s", count, got)
+		}
+
+		sum := modifier
+		for i := int64(0); i < count; i++ {
+			roll, err := strconv.ParseInt(attrs[fmt.Sprintf("rolls.%d", i)], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if roll < 1 || roll > sides {
+				return fmt.Errorf("expected roll between 1 and %d, got %d", sides, roll)
+			}
+			sum += roll
+		}
+
+		if got := attrs["result"]; got != strconv.FormatInt(sum, 10) {
+			return fmt.Errorf("expected result %d, got %s", sum, got)
+		}
+
+		return nil
+	}
+}
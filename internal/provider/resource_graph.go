@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+)
+
+var graphEdgeAttrTypes = map[string]attr.Type{
+	"from": types.Int64Type,
+	"to":   types.Int64Type,
+}
+
+// graphMaxNodes is the maximum value of nodes. It bounds the number of possible edges, which every edge_probability
+// draw walks and which can all end up in state.
+const graphMaxNodes = 1000
+
+var _ tfsdk.ResourceType = (*graphResourceType)(nil)
+
+type graphResourceType struct{}
+
+func (r *graphResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_graph` generates a random graph, as a list of edges between nodes " +
+			"numbered from `0` to `nodes - 1`. Either every possible edge is included with probability " +
+			"`edge_probability` (an Erdős–Rényi G(n, p) graph), or exactly `edge_count` distinct edges are " +
+			"chosen uniformly at random (a G(n, M) graph). Graphs never contain self-loops.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"nodes": {
+				Description: fmt.Sprintf("The number of nodes in the graph. Must be between `0` and `%d`.", graphMaxNodes),
+				Type:        types.Int64Type,
+				Required:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(0, graphMaxNodes),
+				},
+			},
+			"edge_probability": {
+				Description: "The probability, between `0` and `1`, with which each possible edge is " +
+					"included. Exactly one of `edge_probability` or `edge_count` must be set.",
+				Type:     types.Float64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					float64validator.Between(0, 1),
+					schemavalidator.ExactlyOneOf(path.MatchRoot("edge_count")),
+				},
+			},
+			"edge_count": {
+				Description: "The exact number of distinct edges in the graph. Must not exceed the number of " +
+					"possible edges. Exactly one of `edge_probability` or `edge_count` must be set.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+				},
+			},
+			"directed": {
+				Description: "Whether edges are directed. When `false`, each edge is reported once, with " +
+					"`from` lower than `to`. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Bool{Value: false}),
+					planmodifiers.RequiresReplace(),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile graphs.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same graph " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"edges": {
+				Description: "The edges of the graph, ordered by `from` and then `to`.",
+				Type: types.ListType{
+					ElemType: types.ObjectType{
+						AttrTypes: graphEdgeAttrTypes,
+					},
+				},
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *graphResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &graphResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*graphResource)(nil)
+
+type graphResource struct {
+	source *randSource
+}
+
+func (r *graphResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan graphModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodes := plan.Nodes.Value
+	directed := plan.Directed.Value
+
+	possibleEdges := nodes * (nodes - 1)
+	if !directed {
+		possibleEdges /= 2
+	}
+
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
+	var edges [][2]int64
+
+	if plan.EdgeCount.Null {
+		for from := int64(0); from < nodes; from++ {
+			to := int64(0)
+			if !directed {
+				to = from + 1
+			}
+
+			for ; to < nodes; to++ {
+				if to == from {
+					continue
+				}
+
+				if rand.Float64() < plan.EdgeProbability.Value {
+					edges = append(edges, [2]int64{from, to})
+				}
+			}
+		}
+	} else {
+		edgeCount := plan.EdgeCount.Value
+
+		if edgeCount > possibleEdges {
+			resp.Diagnostics.AddError(
+				"Create Random Graph Error",
+				fmt.Sprintf("The edge count (edge_count) value needs to be smaller than or equal to the number "+
+					"of possible edges between %d nodes (%d).", nodes, possibleEdges),
+			)
+			return
+		}
+
+		// Robert Floyd's algorithm draws edgeCount distinct edge indices without enumerating every
+		// possible edge.
+		chosen := make(map[int64]struct{}, edgeCount)
+		for j := possibleEdges - edgeCount; j < possibleEdges; j++ {
+			t := rand.Int63n(j + 1)
+			if _, ok := chosen[t]; ok {
+				t = j
+			}
+			chosen[t] = struct{}{}
+		}
+
+		indices := make([]int64, 0, len(chosen))
+		for i := range chosen {
+			indices = append(indices, i)
+		}
+		sort.Slice(indices, func(i, j int) bool {
+			return indices[i] < indices[j]
+		})
+
+		for _, i := range indices {
+			edges = append(edges, graphEdge(i, nodes, directed))
+		}
+	}
+
+	elems := make([]attr.Value, 0, len(edges))
+	for _, e := range edges {
+		elems = append(elems, types.Object{
+			AttrTypes: graphEdgeAttrTypes,
+			Attrs: map[string]attr.Value{
+				"from": types.Int64{Value: e[0]},
+				"to":   types.Int64{Value: e[1]},
+			},
+		})
+	}
+
+	g := graphModelV0{
+		ID:              staticID(),
+		Keepers:         plan.Keepers,
+		Nodes:           plan.Nodes,
+		EdgeProbability: plan.EdgeProbability,
+		EdgeCount:       plan.EdgeCount,
+		Directed:        plan.Directed,
+		Seed:            plan.Seed,
+		Salt:            plan.Salt,
+		Edges: types.List{
+			Elems: elems,
+			ElemType: types.ObjectType{
+				AttrTypes: graphEdgeAttrTypes,
+			},
+		},
+	}
+
+	diags = resp.State.Set(ctx, g)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *graphResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *graphResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *graphResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// graphEdge maps an index in [0, possible edges) onto the corresponding edge, ordered by from and then to. Directed
+// graphs have nodes - 1 edges leaving each node, while undirected graphs only include edges where from < to.
+func graphEdge(i, nodes int64, directed bool) [2]int64 {
+	if directed {
+		from, to := i/(nodes-1), i%(nodes-1)
+		if to >= from {
+			to++
+		}
+		return [2]int64{from, to}
+	}
+
+	from := int64(0)
+	for row := nodes - 1; i >= row; row-- {
+		i -= row
+		from++
+	}
+
+	return [2]int64{from, from + 1 + i}
+}
+
+type graphModelV0 struct {
+	ID              types.String  `tfsdk:"id"`
+	Keepers         types.Map     `tfsdk:"keepers"`
+	Nodes           types.Int64   `tfsdk:"nodes"`
+	EdgeProbability types.Float64 `tfsdk:"edge_probability"`
+	EdgeCount       types.Int64   `tfsdk:"edge_count"`
+	Directed        types.Bool    `tfsdk:"directed"`
+	Seed            types.String  `tfsdk:"seed"`
+	Salt            types.String  `tfsdk:"salt"`
+	Edges           types.List    `tfsdk:"edges"`
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceGraph_EdgeProbability(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_graph" "complete" {
+							nodes            = 4
+							edge_probability = 1
+						}
+						resource "random_graph" "empty" {
+							nodes            = 4
+							edge_probability = 0
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_graph.complete", "directed", "false"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.#", "6"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.0.from", "0"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.0.to", "1"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.5.from", "2"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.5.to", "3"),
+					resource.TestCheckResourceAttr("random_graph.empty", "edges.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGraph_EdgeCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_graph" "sparse" {
+							nodes      = 100
+							edge_count = 10
+						}
+						resource "random_graph" "complete" {
+							nodes      = 3
+							edge_count = 6
+							directed   = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_graph.sparse", "edges.#", "10"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.#", "6"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.1.from", "0"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.1.to", "2"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.2.from", "1"),
+					resource.TestCheckResourceAttr("random_graph.complete", "edges.2.to", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGraph_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_graph" "graph" {
+							nodes      = 5
+							edge_count = 2
+							seed       = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_graph.graph", "edges.#", "2"),
+					resource.TestCheckResourceAttr("random_graph.graph", "edges.0.from", "1"),
+					resource.TestCheckResourceAttr("random_graph.graph", "edges.0.to", "3"),
+					resource.TestCheckResourceAttr("random_graph.graph", "edges.1.from", "1"),
+					resource.TestCheckResourceAttr("random_graph.graph", "edges.1.to", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGraph_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_graph" "graph" {
+							nodes            = 4
+							edge_probability = 1.5
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 0.000000 and 1.000000, got: 1.500000`),
+			},
+			{
+				Config: `resource "random_graph" "graph" {
+							nodes = 4
+						}`,
+				ExpectError: regexp.MustCompile(`.*No attribute specified when one \(and only one\) of`),
+			},
+			{
+				Config: `resource "random_graph" "graph" {
+							nodes            = 4
+							edge_probability = 0.5
+							edge_count       = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*2 attributes specified when one \(and only one\) of`),
+			},
+			{
+				Config: `resource "random_graph" "graph" {
+							nodes      = 4
+							edge_count = 7
+						}`,
+				ExpectError: regexp.MustCompile(`.*The edge count \(edge_count\) value needs to be smaller than or equal to the\nnumber of possible edges between 4 nodes \(6\).`),
+			},
+			{
+				Config: `resource "random_graph" "graph" {
+							nodes      = 1001
+							edge_count = 1
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 0 and 1000, got: 1001`),
+			},
+		},
+	})
+}
+
+func TestGraphEdge(t *testing.T) {
+	cases := []struct {
+		name     string
+		directed bool
+		expected int
+	}{
+		{
+			name:     "undirected",
+			directed: false,
+			expected: 10,
+		},
+		{
+			name:     "directed",
+			directed: true,
+			expected: 20,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var previous [2]int64
+
+			for i := 0; i < c.expected; i++ {
+				e := graphEdge(int64(i), 5, c.directed)
+
+				if e[0] == e[1] || e[0] < 0 || e[1] < 0 || e[0] >= 5 || e[1] >= 5 {
+					t.Fatalf("index %d: invalid edge %v", i, e)
+				}
+
+				if !c.directed && e[0] > e[1] {
+					t.Fatalf("index %d: expected from < to, got %v", i, e)
+				}
+
+				if i > 0 && (e[0] < previous[0] || (e[0] == previous[0] && e[1] <= previous[1])) {
+					t.Fatalf("index %d: edge %v not ordered after %v", i, e, previous)
+				}
+
+				previous = e
+			}
+		})
+	}
+}
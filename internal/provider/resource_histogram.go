@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.ResourceType = (*histogramResourceType)(nil)
+
+// histogramMaxDraws is the maximum value of draws, which keeps apply from running for a long time, as every sample
+// is drawn individually.
+const histogramMaxDraws = 1000000
+
+type histogramResourceType struct{}
+
+func (r *histogramResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_histogram` draws a number of samples from a set of categories, " +
+			"with each category chosen in proportion to its observed frequency, and reports how many times " +
+			"each category was drawn (i.e. multinomial sampling).",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"frequencies": {
+				Description: "Map of category names to their observed frequency. Frequencies must not be " +
+					"negative and at least one must be greater than zero.",
+				Type: types.MapType{
+					ElemType: types.Int64Type,
+				},
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					mapvalidator.ValuesAre(int64validator.AtLeast(0)),
+				},
+			},
+			"draws": {
+				Description: fmt.Sprintf("The number of samples to draw. Must be between `0` and `%d`.", histogramMaxDraws),
+				Type:        types.Int64Type,
+				Required:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(0, histogramMaxDraws),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile samples.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same samples " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "Map of every category in `frequencies` to the number of times it was drawn.",
+				Type: types.MapType{
+					ElemType: types.Int64Type,
+				},
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *histogramResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &histogramResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*histogramResource)(nil)
+
+type histogramResource struct {
+	source *randSource
+}
+
+func (r *histogramResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan histogramModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Categories are sorted so that the same seed always maps draws onto the same categories,
+	// regardless of map iteration order.
+	categories := make([]string, 0, len(plan.Frequencies.Elems))
+	for k := range plan.Frequencies.Elems {
+		categories = append(categories, k)
+	}
+	sort.Strings(categories)
+
+	var total int64
+	cumulative := make([]int64, len(categories))
+
+	for i, k := range categories {
+		frequency := plan.Frequencies.Elems[k].(types.Int64).Value
+
+		if total > math.MaxInt64-frequency {
+			resp.Diagnostics.AddError(
+				"Create Random Histogram Error",
+				"The sum of all frequencies needs to fit within a 64-bit integer.",
+			)
+			return
+		}
+
+		total += frequency
+		cumulative[i] = total
+	}
+
+	if total == 0 {
+		resp.Diagnostics.AddError(
+			"Create Random Histogram Error",
+			"At least one frequency needs to be greater than zero.",
+		)
+		return
+	}
+
+	counts := make([]int64, len(categories))
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
+
+	for i := int64(0); i < plan.Draws.Value; i++ {
+		n := rand.Int63n(total)
+		idx := sort.Search(len(cumulative), func(j int) bool {
+			return cumulative[j] > n
+		})
+		counts[idx]++
+	}
+
+	result := make(map[string]attr.Value, len(categories))
+	for i, k := range categories {
+		result[k] = types.Int64{Value: counts[i]}
+	}
+
+	h := histogramModelV0{
+		ID:          staticID(),
+		Keepers:     plan.Keepers,
+		Frequencies: plan.Frequencies,
+		Draws:       plan.Draws,
+		Seed:        plan.Seed,
+		Salt:        plan.Salt,
+		Result: types.Map{
+			Elems:    result,
+			ElemType: types.Int64Type,
+		},
+	}
+
+	diags = resp.State.Set(ctx, h)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *histogramResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *histogramResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *histogramResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+type histogramModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Keepers     types.Map    `tfsdk:"keepers"`
+	Frequencies types.Map    `tfsdk:"frequencies"`
+	Draws       types.Int64  `tfsdk:"draws"`
+	Seed        types.String `tfsdk:"seed"`
+	Salt        types.String `tfsdk:"salt"`
+	Result      types.Map    `tfsdk:"result"`
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceHistogram(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_histogram" "histogram_1" {
+							frequencies = {
+								a = 1
+								b = 3
+								c = 0
+							}
+							draws = 100
+							seed  = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_histogram.histogram_1", "result.%", "3"),
+					resource.TestCheckResourceAttr("random_histogram.histogram_1", "result.a", "26"),
+					resource.TestCheckResourceAttr("random_histogram.histogram_1", "result.b", "74"),
+					resource.TestCheckResourceAttr("random_histogram.histogram_1", "result.c", "0"),
+					testAccResourceHistogramCheckTotal("random_histogram.histogram_1", 100),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceHistogram_SinglePositiveFrequency(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_histogram" "histogram_1" {
+							frequencies = {
+								a = 0
+								b = 5
+							}
+							draws = 7
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_histogram.histogram_1", "result.a", "0"),
+					resource.TestCheckResourceAttr("random_histogram.histogram_1", "result.b", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceHistogram_FrequencyErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_histogram" "histogram_1" {
+							frequencies = {
+								a = 0
+								b = 0
+							}
+							draws = 7
+						}`,
+				ExpectError: regexp.MustCompile(`.*At least one frequency needs to be greater than zero.`),
+			},
+			{
+				Config: `resource "random_histogram" "histogram_1" {
+							frequencies = {
+								a = -1
+								b = 2
+							}
+							draws = 7
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 0, got: -1`),
+			},
+			{
+				Config: `resource "random_histogram" "histogram_1" {
+							frequencies = {
+								a = 1
+								b = 2
+							}
+							draws = 1000001
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 0 and 1000000, got: 1000001`),
+			},
+		},
+	})
+}
+
+func testAccResourceHistogramCheckTotal(name string, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		var total int64
+		for k, v := range rs.Primary.Attributes {
+			if !regexp.MustCompile(`^result\.[^%]+$`).MatchString(k) {
+				continue
+			}
+
+			count, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			total += count
+		}
+
+		if total != expected {
+			return fmt.Errorf("expected %d draws in total, got %d", expected, total)
+		}
+
+		return nil
+	}
+}
@@ -6,14 +6,18 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
 var _ tfsdk.ResourceType = (*idResourceType)(nil)
@@ -66,47 +70,154 @@ exist concurrently.
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"prefix_base64": {
+				Description: "Base64-encoded bytes to prepend to the random bytes before they are encoded, " +
+					"e.g. a fixed marker for routing. These bytes are included in every encoding of the " +
+					"result and are not counted by `byte_length`.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"suffix_base64": {
+				Description: "Base64-encoded bytes to append to the random bytes before they are encoded. " +
+					"These bytes are included in every encoding of the result and are not counted by " +
+					"`byte_length`.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"result_length": {
+				Description: "The number of characters of the URL-friendly base64 encoding, i.e. `b64_url` " +
+					"without `prefix`, to keep in `result`, e.g. for names with an exact length. Must be at " +
+					"most the length of that encoding. Each character dropped removes up to six bits of " +
+					"randomness, and the random bits left in `result` are reported in " +
+					"`result_entropy_bits`. `collision_probability` always describes the full id.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"collision_group": {
+				Description: collisionGroupDescription,
+				Type:        types.StringType,
+				Optional:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"expected_count": {
+				Description: "The number of ids expected to be generated with the same `byte_length`, e.g. " +
+					"across all instances of a resource using `count`. Used to compute " +
+					"`collision_probability`. Changing this value does not regenerate the id.",
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"collision_probability": {
+				Description: "The estimated probability that at least two of `expected_count` ids of " +
+					"`byte_length` random bytes are equal, computed using the birthday approximation " +
+					"`1 - exp(-n(n-1) / 2^(8 * byte_length + 1))`. Bytes added by `prefix_base64` and " +
+					"`suffix_base64` are not random and do not change the estimate. The approximation is " +
+					"accurate while `expected_count` is much smaller than the number of possible ids, and " +
+					"overestimates the probability as `expected_count` approaches it. When `expected_count` " +
+					"exceeds the number of possible ids, a collision is certain and the probability is `1`. " +
+					"Only set when `expected_count` is set.",
+				Type:     types.NumberType,
+				Computed: true,
+			},
 			"b64_url": {
 				Description: "The generated id presented in base64, using the URL-friendly character set: " +
 					"case-sensitive letters, digits and the characters `_` and `-`.",
 				Type:     types.StringType,
 				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"b64_std": {
 				Description: "The generated id presented in base64 without additional transformations.",
 				Type:        types.StringType,
 				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"hex": {
 				Description: "The generated id presented in padded hexadecimal digits. This result will " +
 					"always be twice as long as the requested byte length.",
 				Type:     types.StringType,
 				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"dec": {
 				Description: "The generated id presented in non-padded decimal digits.",
 				Type:        types.StringType,
 				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"result": {
+				Description: "The first `result_length` characters of the URL-friendly base64 encoding of " +
+					"the id, after `prefix`. Only set when `result_length` is set.",
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"result_entropy_bits": {
+				Description: "The number of random bits encoded in `result`, i.e. six for each character, " +
+					"less the bits of a final character that only partially encodes the id and the bits " +
+					"taken by `prefix_base64` and `suffix_base64`. Only set when `result_length` is set.",
+				Type:     types.Int64Type,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"id": {
-				Description: "The generated id presented in base64 without additional transformations or prefix.",
-				Type:        types.StringType,
-				Computed:    true,
+				Description: "The generated id presented in base64, using the URL-friendly character set and " +
+					"without padding or prefix, i.e. `b64_url` without `prefix`. This is the same format as " +
+					"the SDKv2 based versions of the provider (v3.3.2 and earlier), so that their state is " +
+					"kept as-is after upgrading.",
+				Type:     types.StringType,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 		},
 	}, nil
 }
 
 func (r *idResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return &idResource{}, nil
+	return &idResource{
+		collisions: p.(*provider).collisions,
+	}, nil
 }
 
 var (
 	_ tfsdk.Resource                = (*idResource)(nil)
 	_ tfsdk.ResourceWithImportState = (*idResource)(nil)
+	_ tfsdk.ResourceWithModifyPlan  = (*idResource)(nil)
 )
 
-type idResource struct{}
+type idResource struct {
+	collisions *collisionRegistry
+}
 
 func (r *idResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	var plan idModelV0
@@ -117,21 +228,71 @@ func (r *idResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest
 		return
 	}
 
-	byteLength := plan.ByteLength.Value
-	bytes := make([]byte, byteLength)
-
-	n, err := rand.Reader.Read(bytes)
-	if int64(n) != byteLength {
-		resp.Diagnostics.Append(diagnostics.RandomnessGenerationError(err.Error())...)
+	prefixBytes, err := base64.StdEncoding.DecodeString(plan.PrefixBase64.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random ID Error",
+			fmt.Sprintf("The prefix_base64 value is not valid base64: %s.", err),
+		)
 		return
 	}
+
+	suffixBytes, err := base64.StdEncoding.DecodeString(plan.SuffixBase64.Value)
 	if err != nil {
-		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+		resp.Diagnostics.AddError(
+			"Create Random ID Error",
+			fmt.Sprintf("The suffix_base64 value is not valid base64: %s.", err),
+		)
 		return
 	}
 
+	byteLength := plan.ByteLength.Value
+	bytes := make([]byte, byteLength)
+
+	for attempt := 1; ; attempt++ {
+		n, err := rand.Reader.Read(bytes)
+		if int64(n) != byteLength {
+			resp.Diagnostics.Append(diagnostics.RandomnessGenerationError(err.Error())...)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+			return
+		}
+
+		if plan.CollisionGroup.Null || r.collisions.register(plan.CollisionGroup.Value, string(bytes)) {
+			break
+		}
+
+		if attempt >= random.MaxAttempts {
+			resp.Diagnostics.Append(collisionError("Create Random ID Error", plan.CollisionGroup.Value)...)
+			return
+		}
+	}
+
+	bytes = append(append(prefixBytes, bytes...), suffixBytes...)
+
 	id := base64.RawURLEncoding.EncodeToString(bytes)
 	prefix := plan.Prefix.Value
+
+	result := types.String{Null: true}
+	resultEntropyBits := types.Int64{Null: true}
+
+	if !plan.ResultLength.Null {
+		if plan.ResultLength.Value > int64(len(id)) {
+			resp.Diagnostics.AddError(
+				"Create Random ID Error",
+				fmt.Sprintf("The result_length value (%d) needs to be at most the length of the base64 encoding "+
+					"of the id (%d).", plan.ResultLength.Value, len(id)),
+			)
+			return
+		}
+
+		result.Null = false
+		result.Value = prefix + id[:plan.ResultLength.Value]
+		resultEntropyBits.Null = false
+		resultEntropyBits.Value = idResultEntropyBits(plan.ResultLength.Value, int64(len(prefixBytes)), byteLength, int64(len(bytes)))
+	}
 	b64Std := base64.StdEncoding.EncodeToString(bytes)
 	hexStr := hex.EncodeToString(bytes)
 
@@ -140,14 +301,22 @@ func (r *idResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest
 	dec := bigInt.String()
 
 	i := idModelV0{
-		ID:         types.String{Value: id},
-		Keepers:    plan.Keepers,
-		ByteLength: types.Int64{Value: plan.ByteLength.Value},
-		Prefix:     plan.Prefix,
-		B64URL:     types.String{Value: prefix + id},
-		B64Std:     types.String{Value: prefix + b64Std},
-		Hex:        types.String{Value: prefix + hexStr},
-		Dec:        types.String{Value: prefix + dec},
+		ID:                   resultID(id),
+		Keepers:              plan.Keepers,
+		ByteLength:           types.Int64{Value: plan.ByteLength.Value},
+		Prefix:               plan.Prefix,
+		PrefixBase64:         plan.PrefixBase64,
+		SuffixBase64:         plan.SuffixBase64,
+		CollisionGroup:       plan.CollisionGroup,
+		ExpectedCount:        plan.ExpectedCount,
+		CollisionProbability: idCollisionProbability(plan.ByteLength, plan.ExpectedCount),
+		B64URL:               types.String{Value: prefix + id},
+		B64Std:               types.String{Value: prefix + b64Std},
+		Hex:                  types.String{Value: prefix + hexStr},
+		Dec:                  types.String{Value: prefix + dec},
+		ResultLength:         plan.ResultLength,
+		Result:               result,
+		ResultEntropyBits:    resultEntropyBits,
 	}
 
 	diags = resp.State.Set(ctx, i)
@@ -161,9 +330,31 @@ func (r *idResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest
 func (r *idResource) Read(context.Context, tfsdk.ReadResourceRequest, *tfsdk.ReadResourceResponse) {
 }
 
-// Update is intentionally left blank as all required and optional attributes force replacement of the resource
-// through the RequiresReplace AttributePlanModifier.
-func (r *idResource) Update(context.Context, tfsdk.UpdateResourceRequest, *tfsdk.UpdateResourceResponse) {
+// Update only stores expected_count and collision_probability, as all other required and optional attributes
+// force replacement of the resource through the RequiresReplace AttributePlanModifier.
+func (r *idResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state idModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ExpectedCount = plan.ExpectedCount
+	state.CollisionProbability = idCollisionProbability(plan.ByteLength, plan.ExpectedCount)
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
@@ -201,7 +392,7 @@ func (r *idResource) ImportState(ctx context.Context, req tfsdk.ImportResourceSt
 
 	var state idModelV0
 
-	state.ID.Value = id
+	state.ID = resultID(id)
 	state.ByteLength.Value = int64(len(bytes))
 	state.Keepers.ElemType = types.StringType
 	state.B64Std.Value = prefix + b64Std
@@ -209,6 +400,15 @@ func (r *idResource) ImportState(ctx context.Context, req tfsdk.ImportResourceSt
 	state.Hex.Value = prefix + hexStr
 	state.Dec.Value = prefix + dec
 
+	state.PrefixBase64.Null = true
+	state.SuffixBase64.Null = true
+	state.CollisionGroup.Null = true
+	state.ExpectedCount.Null = true
+	state.CollisionProbability.Null = true
+	state.ResultLength.Null = true
+	state.Result.Null = true
+	state.ResultEntropyBits.Null = true
+
 	if prefix == "" {
 		state.Prefix.Null = true
 	} else {
@@ -222,13 +422,90 @@ func (r *idResource) ImportState(ctx context.Context, req tfsdk.ImportResourceSt
 	}
 }
 
+// ModifyPlan computes collision_probability during planning, so that the estimate can be reviewed before any id
+// is generated.
+func (r *idResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// The plan is null when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var byteLength, expectedCount types.Int64
+
+	diags := req.Plan.GetAttribute(ctx, path.Root("byte_length"), &byteLength)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Plan.GetAttribute(ctx, path.Root("expected_count"), &expectedCount)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if byteLength.Unknown || expectedCount.Unknown {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("collision_probability"), idCollisionProbability(byteLength, expectedCount))
+	resp.Diagnostics.Append(diags...)
+}
+
+// idCollisionProbability returns the birthday approximation of the probability that at least two of expectedCount
+// ids of byteLength random bytes are equal, or null when expectedCount is not set.
+func idCollisionProbability(byteLength, expectedCount types.Int64) types.Number {
+	if expectedCount.Null {
+		return types.Number{Null: true}
+	}
+
+	bits := 8 * byteLength.Value
+	n := expectedCount.Value
+
+	// With more ids than possible values a collision is certain, which the approximation only approaches.
+	if bits < 63 && n > int64(1)<<bits {
+		return types.Number{Value: big.NewFloat(1)}
+	}
+
+	// The exponent n(n-1) / 2^(bits+1) is computed in floating point, as it overflows int64 for large counts, and
+	// underflows to zero for large byte lengths. Expm1 keeps the precision of very small probabilities.
+	x := math.Ldexp(float64(n)*float64(n-1), -int(bits)-1)
+
+	return types.Number{Value: big.NewFloat(-math.Expm1(-x))}
+}
+
+// idResultEntropyBits returns the number of random bits in the first resultLength characters of the unpadded base64
+// encoding of totalLength bytes, of which byteLength random bytes follow prefixLength fixed ones. Every character
+// encodes six bits, except that the encoding ends with the last bit of the bytes.
+func idResultEntropyBits(resultLength, prefixLength, byteLength, totalLength int64) int64 {
+	encoded := 6 * resultLength
+	if encoded > 8*totalLength {
+		encoded = 8 * totalLength
+	}
+
+	bits := encoded - 8*prefixLength
+	if bits < 0 {
+		return 0
+	}
+
+	if bits > 8*byteLength {
+		return 8 * byteLength
+	}
+
+	return bits
+}
+
 type idModelV0 struct {
-	ID         types.String `tfsdk:"id"`
-	Keepers    types.Map    `tfsdk:"keepers"`
-	ByteLength types.Int64  `tfsdk:"byte_length"`
-	Prefix     types.String `tfsdk:"prefix"`
-	B64URL     types.String `tfsdk:"b64_url"`
-	B64Std     types.String `tfsdk:"b64_std"`
-	Hex        types.String `tfsdk:"hex"`
-	Dec        types.String `tfsdk:"dec"`
+	ID                   types.String `tfsdk:"id"`
+	Keepers              types.Map    `tfsdk:"keepers"`
+	ByteLength           types.Int64  `tfsdk:"byte_length"`
+	Prefix               types.String `tfsdk:"prefix"`
+	PrefixBase64         types.String `tfsdk:"prefix_base64"`
+	SuffixBase64         types.String `tfsdk:"suffix_base64"`
+	CollisionGroup       types.String `tfsdk:"collision_group"`
+	ExpectedCount        types.Int64  `tfsdk:"expected_count"`
+	CollisionProbability types.Number `tfsdk:"collision_probability"`
+	B64URL               types.String `tfsdk:"b64_url"`
+	B64Std               types.String `tfsdk:"b64_std"`
+	Hex                  types.String `tfsdk:"hex"`
+	Dec                  types.String `tfsdk:"dec"`
+	ResultLength         types.Int64  `tfsdk:"result_length"`
+	Result               types.String `tfsdk:"result"`
+	ResultEntropyBits    types.Int64  `tfsdk:"result_entropy_bits"`
 }
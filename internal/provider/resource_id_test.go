@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceID(t *testing.T) {
@@ -56,6 +62,352 @@ func TestAccResourceID_ImportWithPrefix(t *testing.T) {
 	})
 }
 
+// TestAccResourceID_ImportLegacyID imports an id captured from the SDKv2 based provider (v3.3.2 and earlier), which
+// stored the same encodings of the bytes 0x87e484b3 as the current provider.
+func TestAccResourceID_ImportLegacyID(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "bar" {
+  							byte_length = 4
+  							prefix      = "cloud-"
+						}`,
+				ResourceName:  "random_id.bar",
+				ImportState:   true,
+				ImportStateId: "cloud-,h-SEsw",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					expected := map[string]string{
+						"id":          "h-SEsw",
+						"byte_length": "4",
+						"prefix":      "cloud-",
+						"b64_url":     "cloud-h-SEsw",
+						"b64_std":     "cloud-h+SEsw==",
+						"hex":         "cloud-87e484b3",
+						"dec":         "cloud-2279900339",
+					}
+
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(states))
+					}
+
+					for k, v := range expected {
+						if got := states[0].Attributes[k]; got != v {
+							return fmt.Errorf("expected %s to be %q, got %q", k, v, got)
+						}
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_MarkerBytes(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "marker" {
+							byte_length   = 4
+							prefix_base64 = "AQI="
+							suffix_base64 = "/w=="
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_id.marker", "hex", regexp.MustCompile(`^0102[\da-f]{8}ff$`)),
+					resource.TestMatchResourceAttr("random_id.marker", "b64_std", regexp.MustCompile(`^AQ.{6}/w==$`)),
+					resource.TestMatchResourceAttr("random_id.marker", "b64_url", regexp.MustCompile(`^AQ.{6}_w$`)),
+					resource.TestMatchResourceAttr("random_id.marker", "id", regexp.MustCompile(`^AQ.{6}_w$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_MarkerBytesErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "marker" {
+							byte_length   = 4
+							prefix_base64 = "not base64"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The prefix_base64 value is not valid base64`),
+			},
+			{
+				Config: `resource "random_id" "marker" {
+							byte_length   = 4
+							suffix_base64 = "AQI"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The suffix_base64 value is not valid base64`),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_ResultLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "truncated" {
+  							byte_length   = 8
+  							prefix        = "app-"
+  							result_length = 6
+						}
+						resource "random_id" "full" {
+  							byte_length   = 4
+  							result_length = 6
+						}
+						resource "random_id" "marked" {
+  							byte_length   = 4
+  							prefix_base64 = "AAA="
+  							result_length = 4
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_id.truncated", "result", regexp.MustCompile(`^app-[A-Za-z0-9_-]{6}$`)),
+					resource.TestCheckResourceAttr("random_id.truncated", "result_entropy_bits", "36"),
+					resource.TestCheckResourceAttrWith("random_id.truncated", "b64_url", testCheckLen(15)),
+					testAccResourceIDCheckResultPrefix("random_id.truncated"),
+					resource.TestCheckResourceAttrPair("random_id.full", "result", "random_id.full", "b64_url"),
+					resource.TestCheckResourceAttr("random_id.full", "result_entropy_bits", "32"),
+					resource.TestMatchResourceAttr("random_id.marked", "result", regexp.MustCompile(`^AA[A-D][A-Za-z0-9_-]$`)),
+					resource.TestCheckResourceAttr("random_id.marked", "result_entropy_bits", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_ResultLengthErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length   = 4
+  							result_length = 7
+						}`,
+				ExpectError: regexp.MustCompile(`.*The result_length value \(7\) needs to be at most the length of the base64\nencoding of the id \(6\).`),
+			},
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length   = 4
+  							result_length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_CollisionGroup(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+							count           = 32
+							byte_length     = 1
+							collision_group = "bytes"
+						}`,
+				Check: testAccCheckDistinctResults("random_id.foo", 32, "hex"),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_CollisionProbability(t *testing.T) {
+	var hex string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+							byte_length = 1
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttrCapture("random_id.foo", "hex", &hex),
+					resource.TestCheckNoResourceAttr("random_id.foo", "collision_probability"),
+				),
+			},
+			{
+				Config: `resource "random_id" "foo" {
+							byte_length    = 1
+							expected_count = 1
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttrEquals("random_id.foo", "hex", &hex),
+					resource.TestCheckResourceAttr("random_id.foo", "collision_probability", "0"),
+				),
+			},
+			{
+				Config: `resource "random_id" "foo" {
+							byte_length    = 1
+							expected_count = 257
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttrEquals("random_id.foo", "hex", &hex),
+					resource.TestCheckResourceAttr("random_id.foo", "collision_probability", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_ExpectedCountKeepsDependents(t *testing.T) {
+	var dependent string
+
+	config := func(expectedCount int) string {
+		return fmt.Sprintf(`resource "random_id" "foo" {
+								byte_length    = 4
+								result_length  = 4
+								expected_count = %d
+							}
+							resource "random_string" "dependent" {
+								length  = 12
+								keepers = {
+									hex    = random_id.foo.hex
+									result = random_id.foo.result
+								}
+							}`, expectedCount)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(10),
+				Check:  testAccCheckAttrCapture("random_string.dependent", "result", &dependent),
+			},
+			{
+				Config: config(1000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_id.foo", "expected_count", "1000"),
+					testAccCheckAttrEquals("random_string.dependent", "result", &dependent),
+				),
+			},
+		},
+	})
+}
+
+func TestIDCollisionProbability(t *testing.T) {
+	testCases := map[string]struct {
+		byteLength    int64
+		expectedCount int64
+		expected      float64
+	}{
+		"single id": {
+			byteLength:    4,
+			expectedCount: 1,
+			expected:      0,
+		},
+		"birthday problem": {
+			byteLength:    1,
+			expectedCount: 20,
+			expected:      0.52393,
+		},
+		"type-4 uuid equivalent": {
+			byteLength:    16,
+			expectedCount: 1000000000,
+			expected:      1.46937e-21,
+		},
+		"more ids than values": {
+			byteLength:    1,
+			expectedCount: 257,
+			expected:      1,
+		},
+		"large byte length": {
+			byteLength:    1024,
+			expectedCount: math.MaxInt64,
+			expected:      0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := idCollisionProbability(
+				types.Int64{Value: testCase.byteLength},
+				types.Int64{Value: testCase.expectedCount},
+			)
+
+			actual, _ := got.Value.Float64()
+			if math.Abs(actual-testCase.expected) > testCase.expected*1e-4 {
+				t.Errorf("expected %g, got %g", testCase.expected, actual)
+			}
+		})
+	}
+
+	t.Run("no expected count", func(t *testing.T) {
+		t.Parallel()
+
+		got := idCollisionProbability(types.Int64{Value: 4}, types.Int64{Null: true})
+		if !got.Null {
+			t.Errorf("expected null, got %s", got)
+		}
+	})
+}
+
+func TestIDResultEntropyBits(t *testing.T) {
+	testCases := map[string]struct {
+		resultLength, prefixLength, byteLength, totalLength int64
+		expected                                            int64
+	}{
+		"truncated": {
+			resultLength: 6,
+			byteLength:   8,
+			totalLength:  8,
+			expected:     36,
+		},
+		"full length": {
+			resultLength: 6,
+			byteLength:   4,
+			totalLength:  4,
+			expected:     32,
+		},
+		"within prefix": {
+			resultLength: 2,
+			prefixLength: 2,
+			byteLength:   4,
+			totalLength:  6,
+			expected:     0,
+		},
+		"across prefix": {
+			resultLength: 4,
+			prefixLength: 2,
+			byteLength:   4,
+			totalLength:  6,
+			expected:     8,
+		},
+		"into suffix": {
+			resultLength: 8,
+			byteLength:   3,
+			totalLength:  6,
+			expected:     24,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := idResultEntropyBits(testCase.resultLength, testCase.prefixLength, testCase.byteLength, testCase.totalLength)
+			if actual != testCase.expected {
+				t.Errorf("expected %d, got %d", testCase.expected, actual)
+			}
+		})
+	}
+}
+
 func TestAccResourceID_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
@@ -96,3 +448,20 @@ func TestAccResourceID_UpgradeFromVersion3_3_2(t *testing.T) {
 		},
 	})
 }
+
+// testAccResourceIDCheckResultPrefix checks that result is the start of b64_url.
+func testAccResourceIDCheckResultPrefix(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		result, b64URL := rs.Primary.Attributes["result"], rs.Primary.Attributes["b64_url"]
+		if !strings.HasPrefix(b64URL, result) {
+			return fmt.Errorf("expected result %q to be the start of b64_url %q", result, b64URL)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// identifierMaxLength is the maximum length of a DNS label, as given by RFC 1035.
+	identifierMaxLength = 63
+
+	identifierLetters = "abcdefghijklmnopqrstuvwxyz"
+	identifierDigits  = "0123456789"
+)
+
+var _ tfsdk.ResourceType = (*identifierResourceType)(nil)
+
+type identifierResourceType struct{}
+
+func (r *identifierResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_identifier` generates a random DNS label as described by RFC 1035, " +
+			"e.g. for naming cloud resources. The label consists of lowercase letters, digits and hyphens, " +
+			"starts with a letter and ends with a letter or digit, so that it is valid by construction.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"length": {
+				Description: "The length of the label, between 1 and 63. When not set, the length is itself " +
+					"drawn at random from that range.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, identifierMaxLength),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile labels.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same label " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The generated DNS label.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *identifierResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &identifierResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*identifierResource)(nil)
+
+type identifierResource struct {
+	source *randSource
+}
+
+func (r *identifierResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan identifierModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
+
+	length := int(plan.Length.Value)
+	if plan.Length.Null {
+		length = 1 + rand.Intn(identifierMaxLength)
+	}
+
+	i := identifierModelV0{
+		ID:      staticID(),
+		Keepers: plan.Keepers,
+		Length:  plan.Length,
+		Seed:    plan.Seed,
+		Salt:    plan.Salt,
+		Result:  types.String{Value: identifierLabel(rand, length)},
+	}
+
+	diags = resp.State.Set(ctx, i)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *identifierResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *identifierResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *identifierResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// identifierLabel returns a DNS label of the given length drawn from rand. The first character is a letter, the
+// last a letter or digit, and every other character a letter, digit or hyphen.
+func identifierLabel(rand *rand.Rand, length int) string {
+	label := make([]byte, length)
+
+	for i := range label {
+		chars := identifierLetters + identifierDigits + "-"
+		switch {
+		case i == 0:
+			chars = identifierLetters
+		case i == length-1:
+			chars = identifierLetters + identifierDigits
+		}
+
+		label[i] = chars[rand.Intn(len(chars))]
+	}
+
+	return string(label)
+}
+
+type identifierModelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Keepers types.Map    `tfsdk:"keepers"`
+	Length  types.Int64  `tfsdk:"length"`
+	Seed    types.String `tfsdk:"seed"`
+	Salt    types.String `tfsdk:"salt"`
+	Result  types.String `tfsdk:"result"`
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var identifierPattern = regexp.MustCompile(`^[a-z]([a-z0-9-]*[a-z0-9])?$`)
+
+func TestAccResourceIdentifier(t *testing.T) {
+	checks := make([]resource.TestCheckFunc, 0, 20)
+	for i := 0; i < 10; i++ {
+		checks = append(checks,
+			resource.TestMatchResourceAttr(fmt.Sprintf("random_identifier.fixed.%d", i), "result", identifierPattern),
+			resource.TestCheckResourceAttrWith(fmt.Sprintf("random_identifier.fixed.%d", i), "result", testCheckLen(20)),
+			resource.TestMatchResourceAttr(fmt.Sprintf("random_identifier.drawn.%d", i), "result", identifierPattern),
+		)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_identifier" "fixed" {
+							count  = 10
+							length = 20
+						}
+						resource "random_identifier" "drawn" {
+							count = 10
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourceIdentifier_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_identifier" "seeded" {
+							seed = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_identifier.seeded", "result", "hq7eiwubjhtl0upw8h9z28io7yfkj68ovd6zhpt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceIdentifier_LengthErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_identifier" "identifier" {
+							length = 64
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 1 and 63, got: 64`),
+			},
+			{
+				Config: `resource "random_identifier" "identifier" {
+							length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 1 and 63, got: 0`),
+			},
+		},
+	})
+}
+
+func TestIdentifierLabel(t *testing.T) {
+	t.Parallel()
+
+	rand := random.NewRand("12345")
+
+	for length := 1; length <= identifierMaxLength; length++ {
+		for i := 0; i < 100; i++ {
+			label := identifierLabel(rand, length)
+			if len(label) != length || !identifierPattern.MatchString(label) {
+				t.Fatalf("expected a valid DNS label of length %d, got %q", length, label)
+			}
+		}
+	}
+}
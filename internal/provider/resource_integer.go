@@ -2,11 +2,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"math"
+	"math/big"
+	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -15,6 +26,22 @@ import (
 
 var _ tfsdk.ResourceType = (*integerResourceType)(nil)
 
+var (
+	integerCheckDigits = stringEnum{"none", "luhn", "verhoeff"}
+	integerTimeBuckets = stringEnum{"hour", "day", "week"}
+)
+
+// integerMaxOneHotSize is the maximum number of elements in one_hot, which keeps state from growing with the range.
+const integerMaxOneHotSize = 1024
+
+// integerMaxResultCount is the maximum value of result_count, which bounds the size of results and the number of
+// draws made during apply.
+const integerMaxResultCount = 10000
+
+// integerMaxPadWidth is the maximum value of pad_width, well above the 20 characters of the widest int64, which keeps
+// padded from growing without bound.
+const integerMaxPadWidth = 64
+
 type integerResourceType struct{}
 
 func (r *integerResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -28,23 +55,91 @@ func (r *integerResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Dia
 		Attributes: map[string]tfsdk.Attribute{
 			"keepers": {
 				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
-					"resource. See [the main provider documentation](../index.html) for more information.",
+					"resource, or regeneration of `result` in place when `avoid_previous` is `true`. See " +
+					"[the main provider documentation](../index.html) for more information.",
 				Type: types.MapType{
 					ElemType: types.StringType,
 				},
 				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{integerKeepersRequiresReplace{}},
+			},
+			"avoid_previous": {
+				Description: "Regenerate `result` with a value other than the previous one when `keepers` " +
+					"change, e.g. to rotate a port. The resource is then updated in place instead of being " +
+					"replaced, so that the previous result can be read from its state, and the previous " +
+					"result is excluded from the draws like an element of `exclude`. Changes to any other " +
+					"argument still replace the resource, without regard to the previous result. When `seed` " +
+					"is set, every regeneration continues the same sequence of draws, so that the result " +
+					"alternates between two values. Cannot be used with `key` or `mode`. Default value is " +
+					"`false`.",
+				Type:          types.BoolType,
+				Optional:      true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(
+						path.MatchRoot("key"),
+						path.MatchRoot("mode"),
+					),
+				},
 			},
 			"min": {
-				Description:   "The minimum inclusive value of the range.",
+				Description: "The minimum inclusive value of the range. Exactly one of `min` and `max`, " +
+					"`min_string` and `max_string`, or `ranges`, must be set.",
 				Type:          types.Int64Type,
-				Required:      true,
+				Optional:      true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ExactlyOneOf(path.MatchRoot("min_string"), path.MatchRoot("ranges")),
+					schemavalidator.AlsoRequires(path.MatchRoot("max")),
+				},
 			},
 			"max": {
 				Description:   "The maximum inclusive value of the range.",
 				Type:          types.Int64Type,
-				Required:      true,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.AlsoRequires(path.MatchRoot("min")),
+				},
+			},
+			"min_string": {
+				Description: "The minimum inclusive value of the range as a decimal string, e.g. a value read " +
+					"from a tag, for use instead of `min`.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.AlsoRequires(path.MatchRoot("max_string")),
+				},
+			},
+			"max_string": {
+				Description: "The maximum inclusive value of the range as a decimal string, for use instead " +
+					"of `max`.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(path.MatchRoot("max")),
+					schemavalidator.AlsoRequires(path.MatchRoot("min_string")),
+				},
+			},
+			"ranges": {
+				Description: "A list of non-overlapping inclusive ranges to draw from instead of `min` and " +
+					"`max`. Every value in the union of the ranges is equally likely, i.e. each range is " +
+					"chosen in proportion to its size.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"min": {
+						Description: "The minimum inclusive value of the range.",
+						Type:        types.Int64Type,
+						Required:    true,
+					},
+					"max": {
+						Description: "The maximum inclusive value of the range.",
+						Type:        types.Int64Type,
+						Required:    true,
+					},
+				}),
+				Optional:      true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
 			},
 			"seed": {
@@ -53,11 +148,311 @@ func (r *integerResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Dia
 				Optional:      true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
 			},
+			"salt": saltAttribute(),
+			"time_bucket": {
+				Description: "Fold the current time window into the seed, e.g. for sharding by time, so that " +
+					"`result` is stable within the window and is regenerated once it has ended. Windows are " +
+					"UTC hours, UTC days, or ISO weeks starting on Monday at midnight UTC, and the window in " +
+					"which `result` was drawn is stored in `bucket`. Values only rotate when Terraform runs: " +
+					"the first plan in a new window replaces the resource. With the same arguments, every " +
+					"resource draws the same value within a window, also across recreation; set `seed` to " +
+					"draw different values. Cannot be used with `key`. " + integerTimeBuckets.Description(),
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					integerTimeBuckets.Validator(),
+					schemavalidator.ConflictsWith(path.MatchRoot("key")),
+				},
+			},
+			"key": {
+				Description: "A stable key, such as a tenant ID, to map onto the range without using " +
+					"randomness, e.g. for sharding. When set, `result` is the 64-bit FNV-1a hash of the key " +
+					"modulo the number of values in the range, so the same key and range always produce the " +
+					"same result. Different keys may produce the same result: collisions become likely once " +
+					"the number of keys approaches the square root of the number of values in the range. " +
+					"Cannot be used with `seed`, `result_count` or `exclude`.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(
+						path.MatchRoot("seed"),
+						path.MatchRoot("result_count"),
+						path.MatchRoot("exclude"),
+					),
+				},
+			},
+			"exclude": {
+				Description: "A list of values that `result` and `results` never take. Values outside of the " +
+					"range are ignored. While at least a tenth of the values in the range remain, values are " +
+					"re-drawn until one is not excluded, so that large ranges need no additional memory. " +
+					"Otherwise the remaining values are listed and one is picked from the list. Values may " +
+					"reference the results of other resources, e.g. `[random_integer.a.result]` to build a pool " +
+					"of distinct values, and must not be null.",
+				Type: types.ListType{
+					ElemType: types.Int64Type,
+				},
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+			},
+			"modulus": {
+				Description: "Only draw values `x` for which `x % modulus == residue`, e.g. `modulus = 7` and " +
+					"`residue = 3` only produce values such as `3`, `10` or `-4`. The remainder is always " +
+					"non-negative, also for negative values. Requires `residue`. The number of such values in " +
+					"the range is computed directly, so that a large modulus does not require re-drawing.",
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+					schemavalidator.AlsoRequires(path.MatchRoot("residue")),
+				},
+			},
+			"residue": {
+				Description: "The remainder that every drawn value leaves when divided by `modulus`. Must be " +
+					"smaller than `modulus`, and at least one value in the range must leave this remainder. " +
+					"Requires `modulus`.",
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+					schemavalidator.AlsoRequires(path.MatchRoot("modulus")),
+				},
+			},
+			"mode": {
+				Description: "Draw values from a triangular distribution over the range instead of uniformly: " +
+					"the probability of a value is highest at `mode` and falls off linearly towards `min` and " +
+					"`max`, e.g. `min = 1`, `max = 10` and `mode = 8` favour values around `8`. Setting `mode` " +
+					"to `min` or `max` makes the probability fall off in one direction only. Must be between " +
+					"`min` and `max`. Values are drawn in floating point, so for ranges of more than 2^53 " +
+					"values not every value can be drawn. Cannot be used with `ranges`, `key`, `exclude` or " +
+					"`modulus`.",
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(
+						path.MatchRoot("ranges"),
+						path.MatchRoot("key"),
+						path.MatchRoot("exclude"),
+						path.MatchRoot("modulus"),
+					),
+				},
+			},
+			"check_digit": {
+				Description: "The algorithm used to compute a check digit for `result_with_check`. " +
+					integerCheckDigits.Description() + " Default value is `none`.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					integerCheckDigits.Validator(),
+				},
+			},
+			"base": {
+				Description: "The base, between 2 and 36, in which `encoded` represents `result`, using the " +
+					"digits `0-9` followed by the lowercase letters `a-z`.",
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(2, 36),
+				},
+			},
+			"digit_count": {
+				Description: "Only draw values that have exactly this many digits in `base`, i.e. values from " +
+					"`base^(digit_count - 1)` to `base^digit_count - 1`, so that `encoded` has a fixed width, " +
+					"e.g. `base = 36` and `digit_count = 4` draw values from `1000` to `zzzz`. These values " +
+					"are intersected with the range given by `min` and `max` or `ranges`, which must contain " +
+					"at least one of them, and must fit within a 64-bit integer. Requires `base`.",
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+					schemavalidator.AlsoRequires(path.MatchRoot("base")),
+				},
+			},
+			"pad_width": {
+				Description: "The width, including any minus sign, to which `padded` left-pads `result` with " +
+					"zeros. Must be at least the width of both `min` and `max`, so every possible result has " +
+					fmt.Sprintf("the same width, and at most `%d`.", integerMaxPadWidth),
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, integerMaxPadWidth),
+				},
+			},
+			"output_template": {
+				Description: "A template used to produce `formatted`, in which `{result}` is replaced by " +
+					"`result` and `{padded}` by `padded`, e.g. `SRV-{padded}-X`. The template must reference at " +
+					"least one placeholder, and `{padded}` requires `pad_width` to be set.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+			},
+			"result_count": {
+				Description: "The number of values to draw into `results`. When set, `results` holds " +
+					"`result` followed by `result_count - 1` further draws from the same range. Must be " +
+					fmt.Sprintf("between `1` and `%d`.", integerMaxResultCount),
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, integerMaxResultCount),
+				},
+			},
+			"min_distance": {
+				Description: "The minimum difference between any two values in `results`. Requires " +
+					"`result_count`. Each value is re-drawn until it is at least this far from every value " +
+					fmt.Sprintf("drawn before it, giving up after %d attempts.", random.MaxAttempts),
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+					schemavalidator.AlsoRequires(path.MatchRoot("result_count")),
+				},
+			},
+			"common_difference": {
+				Description: "Turn `results` into an arithmetic progression, in which only the start is " +
+					"random: `results` holds `result`, `result + common_difference`, " +
+					"`result + 2 * common_difference` and so on. Requires `result_count`, and cannot be used " +
+					"with `common_ratio` or `min_distance`. The values after `result` may lie outside of the " +
+					"range, but every value must fit within a 64-bit integer for every possible `result`.",
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.AlsoRequires(path.MatchRoot("result_count")),
+					schemavalidator.ConflictsWith(
+						path.MatchRoot("common_ratio"),
+						path.MatchRoot("min_distance"),
+					),
+				},
+			},
+			"common_ratio": {
+				Description: "Turn `results` into a geometric progression, in which only the start is " +
+					"random: `results` holds `result`, `result * common_ratio`, " +
+					"`result * common_ratio * common_ratio` and so on. Must not be `0`. Requires " +
+					"`result_count`, and cannot be used with `common_difference` or `min_distance`. The values " +
+					"after `result` may lie outside of the range, but every value must fit within a 64-bit " +
+					"integer for every possible `result`.",
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.AlsoRequires(path.MatchRoot("result_count")),
+					schemavalidator.ConflictsWith(path.MatchRoot("min_distance")),
+				},
+			},
+			"one_hot_encode": {
+				Description: "Set to `true` to produce `one_hot`. The range from the lowest to the highest " +
+					fmt.Sprintf("value may contain at most %d values.", integerMaxOneHotSize),
+				Type:          types.BoolType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+			},
 			"result": {
 				Description: "The random integer result.",
 				Type:        types.Int64Type,
 				Computed:    true,
 			},
+			"bucket": {
+				Description: "The start of the time window in which `result` was drawn, in RFC 3339 format, " +
+					"e.g. `2022-07-04T13:00:00Z`. Only set when `time_bucket` is set.",
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"normalized": {
+				Description: "The position of `result` within the range, expressed as " +
+					"`(result - min) / (max - min)`, i.e. a value between `0` and `1` inclusive. When `ranges` " +
+					"is set, `min` and `max` are the lowest and highest values of all ranges. This is `0` when " +
+					"the range consists of a single value.",
+				Type:     types.NumberType,
+				Computed: true,
+			},
+			"percentile": {
+				Description: "The percentage of the values of the range that are lower than `result`, " +
+					"expressed as `100 * (result - min) / (max - min + 1)`, i.e. a value from `0` up to but " +
+					"not including `100`. When `ranges` is set, `min` and `max` are the lowest and highest " +
+					"values of all ranges. Every value of a range from `0` to `99` is its own percentile, so " +
+					"that a condition such as `percentile >= 0 && percentile < 25` selects exactly a quarter " +
+					"of the values, e.g. to place resources in a rollout bucket. When `seed` is set, the " +
+					"bucket is the same for every run.",
+				Type:     types.NumberType,
+				Computed: true,
+			},
+			"one_hot": {
+				Description: "A list of booleans with one element for every value from `min` to `max`, in " +
+					"which only the element at index `result - min` is `true`. When `ranges` is set, `min` and " +
+					"`max` are the lowest and highest values of all ranges. Only set when `one_hot_encode` is " +
+					"`true`.",
+				Type: types.ListType{
+					ElemType: types.BoolType,
+				},
+				Computed: true,
+			},
+			"results": {
+				Description: "The `result_count` random integers drawn from the range, starting with " +
+					"`result`. Only set when `result_count` is set.",
+				Type: types.ListType{
+					ElemType: types.Int64Type,
+				},
+				Computed: true,
+			},
+			"histogram": {
+				Description: "Map of every distinct value in `results`, in decimal, to the number of times it " +
+					"occurs in `results`. Only set when `result_count` is set.",
+				Type: types.MapType{
+					ElemType: types.Int64Type,
+				},
+				Computed: true,
+			},
+			"sum": {
+				Description: "The sum of the values in `results`. An error is raised when the sum does not fit " +
+					"within a 64-bit integer. Only set when `result_count` is set.",
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"min_value": {
+				Description: "The smallest value in `results`. Only set when `result_count` is set.",
+				Type:        types.Int64Type,
+				Computed:    true,
+			},
+			"max_value": {
+				Description: "The largest value in `results`. Only set when `result_count` is set.",
+				Type:        types.Int64Type,
+				Computed:    true,
+			},
+			"encoded": {
+				Description: "The representation of `result` in `base`, e.g. `a1b2`. Only set when `base` is " +
+					"set.",
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"padded": {
+				Description: "The decimal representation of `result`, left-padded with zeros to `pad_width` " +
+					"characters, e.g. `00042` or `-0042`. Only set when `pad_width` is set.",
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"formatted": {
+				Description: "The result of `output_template` with its placeholders replaced. Only set when " +
+					"`output_template` is set.",
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"result_with_check": {
+				Description: "The decimal representation of `result` with the check digit described by " +
+					"`check_digit` appended. The check digit is computed over the digits of the absolute value " +
+					"of `result`. Only set when `check_digit` is `luhn` or `verhoeff`.",
+				Type:     types.StringType,
+				Computed: true,
+			},
 			"id": {
 				Description: "The string representation of the integer result.",
 				Type:        types.StringType,
@@ -67,16 +462,23 @@ func (r *integerResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Dia
 	}, nil
 }
 
-func (r *integerResourceType) NewResource(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return &integerResource{}, nil
+func (r *integerResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &integerResource{
+		source: p.(*provider).source,
+		now:    p.(*provider).now,
+	}, nil
 }
 
 var (
 	_ tfsdk.Resource                = (*integerResource)(nil)
 	_ tfsdk.ResourceWithImportState = (*integerResource)(nil)
+	_ tfsdk.ResourceWithModifyPlan  = (*integerResource)(nil)
 )
 
-type integerResource struct{}
+type integerResource struct {
+	source *randSource
+	now    func() time.Time
+}
 
 func (r *integerResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	var plan integerModelV0
@@ -87,35 +489,12 @@ func (r *integerResource) Create(ctx context.Context, req tfsdk.CreateResourceRe
 		return
 	}
 
-	max := int(plan.Max.Value)
-	min := int(plan.Min.Value)
-	seed := plan.Seed.Value
-
-	if max < min {
-		resp.Diagnostics.AddError(
-			"Create Random Integer Error",
-			"The minimum (min) value needs to be smaller than or equal to maximum (max) value.",
-		)
+	u, diags := r.generate("Create Random Integer Error", plan, types.Int64{Null: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	rand := random.NewRand(seed)
-	number := rand.Intn((max+1)-min) + min
-
-	u := &integerModelV0{
-		ID:      types.String{Value: strconv.Itoa(number)},
-		Keepers: plan.Keepers,
-		Min:     types.Int64{Value: int64(min)},
-		Max:     types.Int64{Value: int64(max)},
-		Result:  types.Int64{Value: int64(number)},
-	}
-
-	if seed != "" {
-		u.Seed.Value = seed
-	} else {
-		u.Seed.Null = true
-	}
-
 	diags = resp.State.Set(ctx, u)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -127,9 +506,34 @@ func (r *integerResource) Create(ctx context.Context, req tfsdk.CreateResourceRe
 func (r *integerResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
 }
 
-// Update is intentionally left blank as all required and optional attributes force replacement of the resource
-// through the RequiresReplace AttributePlanModifier.
+// Update regenerates the result when keepers change while avoid_previous is true, which is the only change that
+// does not force replacement of the resource, so that the previous result can be read from the prior state.
 func (r *integerResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state integerModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	u, diags := r.generate("Update Random Integer Error", plan, state.Result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, u)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
@@ -147,7 +551,7 @@ func (r *integerResource) ImportState(ctx context.Context, req tfsdk.ImportResou
 		return
 	}
 
-	result, err := strconv.ParseInt(parts[0], 10, 64)
+	result, err := parseIntegerImportPart(parts[0])
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Random Integer Error",
@@ -157,7 +561,7 @@ func (r *integerResource) ImportState(ctx context.Context, req tfsdk.ImportResou
 		return
 	}
 
-	min, err := strconv.ParseInt(parts[1], 10, 64)
+	min, err := parseIntegerImportPart(parts[1])
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Random Integer Error",
@@ -167,7 +571,7 @@ func (r *integerResource) ImportState(ctx context.Context, req tfsdk.ImportResou
 		return
 	}
 
-	max, err := strconv.ParseInt(parts[2], 10, 64)
+	max, err := parseIntegerImportPart(parts[2])
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Random Integer Error",
@@ -179,16 +583,52 @@ func (r *integerResource) ImportState(ctx context.Context, req tfsdk.ImportResou
 
 	var state integerModelV0
 
-	state.ID.Value = parts[0]
+	state.ID = resultID(strconv.FormatInt(result, 10))
 	state.Keepers.ElemType = types.StringType
 	state.Result.Value = result
 	state.Min.Value = min
 	state.Max.Value = max
+	state.MinString.Null = true
+	state.MaxString.Null = true
+	state.Normalized.Value = integerNormalized(result, min, max)
+	state.Percentile.Value = integerPercentile(result, min, max)
 
 	if len(parts) == 4 {
 		state.Seed.Value = parts[3]
 	}
 
+	state.Salt.Null = true
+
+	state.Key.Null = true
+	state.Exclude = types.List{ElemType: types.Int64Type, Null: true}
+	state.Modulus.Null = true
+	state.Residue.Null = true
+	state.Mode.Null = true
+	state.CheckDigit.Null = true
+	state.ResultWithCheck.Null = true
+	state.Base.Null = true
+	state.DigitCount.Null = true
+	state.Encoded.Null = true
+	state.PadWidth.Null = true
+	state.Padded.Null = true
+	state.Ranges = types.List{ElemType: integerRangeType, Null: true}
+	state.OutputTemplate.Null = true
+	state.Formatted.Null = true
+	state.ResultCount.Null = true
+	state.MinDistance.Null = true
+	state.CommonDifference.Null = true
+	state.CommonRatio.Null = true
+	state.Results = types.List{ElemType: types.Int64Type, Null: true}
+	state.Histogram = types.Map{ElemType: types.Int64Type, Null: true}
+	state.Sum.Null = true
+	state.MinValue.Null = true
+	state.MaxValue.Null = true
+	state.OneHotEncode.Null = true
+	state.AvoidPrevious.Null = true
+	state.TimeBucket.Null = true
+	state.Bucket.Null = true
+	state.OneHot = types.List{ElemType: types.BoolType, Null: true}
+
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -196,11 +636,1011 @@ func (r *integerResource) ImportState(ctx context.Context, req tfsdk.ImportResou
 	}
 }
 
+// ModifyPlan sets bucket to the start of the current time window when time_bucket is set, and replaces the resource
+// once the window in which the result was drawn has ended.
+func (r *integerResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// The plan is null when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var timeBucket types.String
+
+	diags := req.Plan.GetAttribute(ctx, path.Root("time_bucket"), &timeBucket)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || timeBucket.Unknown {
+		return
+	}
+
+	bucket := integerTimeBucketStart(r.now(), timeBucket)
+
+	if !req.State.Raw.IsNull() {
+		var stateBucket types.String
+
+		diags = req.State.GetAttribute(ctx, path.Root("bucket"), &stateBucket)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !bucket.Equal(stateBucket) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("bucket"))
+		}
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("bucket"), bucket)
+	resp.Diagnostics.Append(diags...)
+}
+
+// generate returns the state of a random integer drawn for plan. When previous is not null, it is excluded from
+// the draws like an element of exclude. Errors are reported with summary, so that they name the calling operation.
+func (r *integerResource) generate(summary string, plan integerModelV0, previous types.Int64) (*integerModelV0, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	seed := plan.Seed.Value
+
+	bounds := integerRange{min: int(plan.Min.Value), max: int(plan.Max.Value)}
+	if !plan.MinString.Null {
+		min, err := strconv.ParseInt(plan.MinString.Value, 10, 64)
+		if err != nil {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("The min_string value %q could not be parsed as a 64-bit integer.", plan.MinString.Value),
+			)
+			return nil, diags
+		}
+
+		max, err := strconv.ParseInt(plan.MaxString.Value, 10, 64)
+		if err != nil {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("The max_string value %q could not be parsed as a 64-bit integer.", plan.MaxString.Value),
+			)
+			return nil, diags
+		}
+
+		bounds = integerRange{min: int(min), max: int(max)}
+	}
+
+	ranges := []integerRange{bounds}
+	if !plan.Ranges.Null {
+		ranges = make([]integerRange, 0, len(plan.Ranges.Elems))
+		for _, v := range plan.Ranges.Elems {
+			attrs := v.(types.Object).Attrs
+			ranges = append(ranges, integerRange{
+				min: int(attrs["min"].(types.Int64).Value),
+				max: int(attrs["max"].(types.Int64).Value),
+			})
+		}
+
+		// Ranges are sorted so that the same seed always maps draws onto the same values, regardless of the
+		// order in which they are configured.
+		sort.Slice(ranges, func(i, j int) bool {
+			return ranges[i].min < ranges[j].min
+		})
+	}
+
+	if len(ranges) == 0 {
+		diags.AddError(
+			summary,
+			"At least one range needs to be given in ranges.",
+		)
+		return nil, diags
+	}
+
+	var size uint64
+	for i, rng := range ranges {
+		if rng.max < rng.min {
+			diags.AddError(
+				summary,
+				"The minimum (min) value needs to be smaller than or equal to maximum (max) value.",
+			)
+			return nil, diags
+		}
+
+		if i > 0 && rng.min <= ranges[i-1].max {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("The ranges %d-%d and %d-%d overlap. Ranges need to be non-overlapping.",
+					ranges[i-1].min, ranges[i-1].max, rng.min, rng.max),
+			)
+			return nil, diags
+		}
+
+		rangeSize := uint64(rng.max) - uint64(rng.min) + 1
+		if rangeSize == 0 || size+rangeSize < size || size+rangeSize > math.MaxInt64 {
+			diags.AddError(
+				summary,
+				"The total number of values in all ranges needs to fit within a 64-bit integer.",
+			)
+			return nil, diags
+		}
+
+		size += rangeSize
+	}
+
+	if !plan.DigitCount.Null {
+		lo, hi, ok := integerDigitBounds(plan.Base.Value, plan.DigitCount.Value)
+		if !ok {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("The values with %d digits in base %d do not fit within a 64-bit integer. ", plan.DigitCount.Value, plan.Base.Value)+
+					"Reduce digit_count.",
+			)
+			return nil, diags
+		}
+
+		ranges = integerIntersect(ranges, integerRange{min: int(lo), max: int(hi)})
+		if len(ranges) == 0 {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("No value in the range has exactly %d digits in base %d (digit_count and base), ", plan.DigitCount.Value, plan.Base.Value)+
+					fmt.Sprintf("i.e. lies between %d and %d.", lo, hi),
+			)
+			return nil, diags
+		}
+
+		size = 0
+		for _, rng := range ranges {
+			size += uint64(rng.max) - uint64(rng.min) + 1
+		}
+	}
+
+	// When modulus is set, values are drawn from the positions of the values that leave the residue, which valueAt
+	// maps back onto the values themselves.
+	draws := ranges
+	valueAt := func(n int) int { return n }
+
+	// Elements of exclude may reference the results of other resources, which are known once Create is called.
+	for i, v := range plan.Exclude.Elems {
+		if v.IsNull() {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("The exclude list needs to contain numbers only, got null at index %d.", i),
+			)
+			return nil, diags
+		}
+	}
+
+	exclude := plan.Exclude
+	if !previous.Null {
+		exclude = types.List{
+			ElemType: types.Int64Type,
+			Elems:    append(append([]attr.Value{}, plan.Exclude.Elems...), previous),
+		}
+	}
+
+	excluded := integerExcluded(exclude, ranges)
+
+	if !plan.Modulus.Null {
+		if plan.Residue.Value >= plan.Modulus.Value {
+			diags.AddError(
+				summary,
+				"The residue value needs to be smaller than the modulus value.",
+			)
+			return nil, diags
+		}
+
+		c := newIntegerCongruence(ranges, int(plan.Modulus.Value), int(plan.Residue.Value))
+		if len(c.positions) == 0 {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("No value in the range leaves a remainder of %d when divided by %d (residue and modulus).",
+					plan.Residue.Value, plan.Modulus.Value),
+			)
+			return nil, diags
+		}
+
+		draws, valueAt = c.positions, c.value
+		excluded = c.excluded(excluded)
+
+		size = 0
+		for _, p := range draws {
+			size += uint64(p.max) - uint64(p.min) + 1
+		}
+	}
+
+	// Exclude cannot change without replacing the resource, so when it leaves no value while avoiding the previous
+	// result, the previous result was the only value left.
+	if uint64(len(excluded)) == size && !previous.Null {
+		diags.AddError(
+			summary,
+			"The previous result is the only value in the range that is not excluded (exclude), so no "+
+				"different result can be drawn (avoid_previous). At least two values need to remain.",
+		)
+		return nil, diags
+	}
+
+	if uint64(len(excluded)) == size {
+		diags.AddError(
+			summary,
+			"Every value in the range is excluded (exclude). At least one value needs to remain.",
+		)
+		return nil, diags
+	}
+
+	min := ranges[0].min
+	max := ranges[len(ranges)-1].max
+
+	if !plan.PadWidth.Null {
+		width := plan.PadWidth.Value
+		if int64(len(strconv.Itoa(min))) > width || int64(len(strconv.Itoa(max))) > width {
+			diags.AddError(
+				summary,
+				"The pad width (pad_width) value needs to be greater than or equal to the number of characters "+
+					"in both the minimum (min) and maximum (max) values.",
+			)
+			return nil, diags
+		}
+	}
+
+	if plan.OneHotEncode.Value && uint64(max)-uint64(min) >= integerMaxOneHotSize {
+		diags.AddError(
+			summary,
+			fmt.Sprintf("The range from min to max needs to contain at most %d values when one_hot_encode is true.", integerMaxOneHotSize),
+		)
+		return nil, diags
+	}
+
+	if !plan.Mode.Null && (plan.Mode.Value < int64(min) || plan.Mode.Value > int64(max)) {
+		diags.AddError(
+			summary,
+			"The mode value needs to be between the minimum (min) and maximum (max) values.",
+		)
+		return nil, diags
+	}
+
+	if plan.CommonRatio.Value == 0 && !plan.CommonRatio.Null {
+		diags.AddError(
+			summary,
+			"The common_ratio value needs to be non-zero.",
+		)
+		return nil, diags
+	}
+
+	// Every value of a progression is monotonic in its start, or its magnitude is for a geometric progression, so the
+	// progression fits within a 64-bit integer for every start in the range when it does for both ends of the range.
+	progression := !plan.CommonDifference.Null || !plan.CommonRatio.Null
+	if progression {
+		for _, start := range []int{min, max} {
+			if _, err := integerProgression(int64(start), plan.ResultCount.Value, plan.CommonDifference, plan.CommonRatio); err != nil {
+				diags.AddError(
+					summary,
+					fmt.Sprintf("The progression of %d values starting at %d does not fit within a 64-bit integer: %s. ", plan.ResultCount.Value, start, err)+
+						"Reduce result_count, common_difference or common_ratio, or narrow the range.",
+				)
+				return nil, diags
+			}
+		}
+	}
+
+	if !plan.MinDistance.Null && !plan.ResultCount.Null {
+		span := uint64(max) - uint64(min)
+		gaps := uint64(plan.ResultCount.Value - 1)
+		if gaps > 0 && span/gaps < uint64(plan.MinDistance.Value) {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("The range from min to max cannot accommodate %d values ", plan.ResultCount.Value)+
+					fmt.Sprintf("that are at least %d apart (min_distance).", plan.MinDistance.Value),
+			)
+			return nil, diags
+		}
+	}
+
+	bucket := plan.Bucket
+	if bucket.Unknown {
+		bucket = integerTimeBucketStart(r.now(), plan.TimeBucket)
+	}
+
+	randSeed := seed
+	if !bucket.Null {
+		// The window is folded into the seed, so that the same arguments draw the same value within a window.
+		randSeed = seed + "\x00" + bucket.Value
+	}
+
+	rand := r.source.newRand(randSeed, plan.Salt.Value)
+
+	draw := func() int { return valueAt(integerDrawExcluding(rand, draws, excluded)) }
+	if !plan.Mode.Null {
+		draw = func() int { return integerTriangular(rand, min, max, int(plan.Mode.Value)) }
+	}
+
+	var number int
+	if plan.Key.Null {
+		number = draw()
+	} else {
+		number = valueAt(integerFromKey(plan.Key.Value, draws))
+	}
+
+	u := &integerModelV0{
+		ID:               resultID(strconv.Itoa(number)),
+		Keepers:          plan.Keepers,
+		Min:              plan.Min,
+		Max:              plan.Max,
+		MinString:        plan.MinString,
+		MaxString:        plan.MaxString,
+		Ranges:           plan.Ranges,
+		Key:              plan.Key,
+		Exclude:          plan.Exclude,
+		Modulus:          plan.Modulus,
+		Residue:          plan.Residue,
+		Mode:             plan.Mode,
+		CheckDigit:       plan.CheckDigit,
+		Base:             plan.Base,
+		DigitCount:       plan.DigitCount,
+		PadWidth:         plan.PadWidth,
+		OutputTemplate:   plan.OutputTemplate,
+		ResultCount:      plan.ResultCount,
+		MinDistance:      plan.MinDistance,
+		CommonDifference: plan.CommonDifference,
+		CommonRatio:      plan.CommonRatio,
+		OneHotEncode:     plan.OneHotEncode,
+		AvoidPrevious:    plan.AvoidPrevious,
+		TimeBucket:       plan.TimeBucket,
+		Result:           types.Int64{Value: int64(number)},
+		Normalized:       types.Number{Value: integerNormalized(int64(number), int64(min), int64(max))},
+		Percentile:       types.Number{Value: integerPercentile(int64(number), int64(min), int64(max))},
+		Results:          types.List{ElemType: types.Int64Type, Null: true},
+		Histogram:        types.Map{ElemType: types.Int64Type, Null: true},
+		OneHot:           types.List{ElemType: types.BoolType, Null: true},
+		Bucket:           bucket,
+	}
+
+	if plan.OneHotEncode.Value {
+		oneHot := make([]attr.Value, max-min+1)
+		for i := range oneHot {
+			oneHot[i] = types.Bool{Value: i == number-min}
+		}
+
+		u.OneHot.Null = false
+		u.OneHot.Elems = oneHot
+	}
+
+	var results []int64
+
+	if progression {
+		// The progression was checked to fit for every possible start above.
+		results, _ = integerProgression(int64(number), plan.ResultCount.Value, plan.CommonDifference, plan.CommonRatio)
+	} else if !plan.ResultCount.Null {
+		results = []int64{int64(number)}
+
+	Draws:
+		for int64(len(results)) < plan.ResultCount.Value {
+			for attempt := 0; attempt < random.MaxAttempts; attempt++ {
+				candidate := int64(draw())
+				if plan.MinDistance.Null || integerDistanceAtLeast(candidate, results, plan.MinDistance.Value) {
+					results = append(results, candidate)
+					continue Draws
+				}
+			}
+
+			diags.AddError(
+				summary,
+				fmt.Sprintf("Unable to draw %d values that are at least %d apart (min_distance) ", plan.ResultCount.Value, plan.MinDistance.Value)+
+					fmt.Sprintf("within %d attempts. Reduce result_count or min_distance, or widen the range.", random.MaxAttempts),
+			)
+			return nil, diags
+		}
+	}
+
+	if results == nil {
+		u.Sum.Null = true
+		u.MinValue.Null = true
+		u.MaxValue.Null = true
+	} else {
+		sum, ok := integerSum(results)
+		if !ok {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("The sum of the %d values in results does not fit within a 64-bit integer. ", len(results))+
+					"Reduce result_count, or narrow the range.",
+			)
+			return nil, diags
+		}
+
+		u.Results.Null = false
+		u.Histogram.Null = false
+		u.Results.Elems, u.Histogram.Elems = integerResultsAttrs(results)
+		u.Sum.Value = sum
+		u.MinValue.Value, u.MaxValue.Value = results[0], results[0]
+		for _, v := range results[1:] {
+			if v < u.MinValue.Value {
+				u.MinValue.Value = v
+			}
+			if v > u.MaxValue.Value {
+				u.MaxValue.Value = v
+			}
+		}
+	}
+
+	if plan.Base.Null {
+		u.Encoded.Null = true
+	} else {
+		u.Encoded.Value = strconv.FormatInt(int64(number), int(plan.Base.Value))
+	}
+
+	if plan.PadWidth.Null {
+		u.Padded.Null = true
+	} else {
+		u.Padded.Value = fmt.Sprintf("%0*d", plan.PadWidth.Value, number)
+	}
+
+	if plan.OutputTemplate.Null {
+		u.Formatted.Null = true
+	} else {
+		formatted, err := integerFormat(plan.OutputTemplate.Value, int64(number), u.Padded)
+		if err != nil {
+			diags.AddError(
+				summary,
+				fmt.Sprintf("The output template (output_template) value is invalid: %s.", err),
+			)
+			return nil, diags
+		}
+
+		u.Formatted.Value = formatted
+	}
+
+	resultWithCheck, err := integerResultWithCheck(int64(number), plan.CheckDigit)
+	if err != nil {
+		diags.AddError(
+			summary,
+			"The check digit could not be computed.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return nil, diags
+	}
+
+	u.ResultWithCheck = resultWithCheck
+
+	if seed != "" {
+		u.Seed.Value = seed
+	} else {
+		u.Seed.Null = true
+	}
+
+	u.Salt = plan.Salt
+
+	return u, diags
+}
+
+// integerTimeBucketStart returns the start of the window of timeBucket containing now in RFC 3339 format, or null
+// when timeBucket is null. Weeks start on Monday, following ISO 8601.
+func integerTimeBucketStart(now time.Time, timeBucket types.String) types.String {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var start time.Time
+
+	switch timeBucket.Value {
+	case "hour":
+		start = now.Truncate(time.Hour)
+	case "day":
+		start = day
+	case "week":
+		start = day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	default:
+		return types.String{Null: true}
+	}
+
+	return types.String{Value: start.Format(time.RFC3339)}
+}
+
+// integerKeepersRequiresReplace requires replacement of the resource when keepers change, unless avoid_previous is
+// true, in which case Update regenerates the result in place.
+type integerKeepersRequiresReplace struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m integerKeepersRequiresReplace) Description(ctx context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless " +
+		"avoid_previous is true."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m integerKeepersRequiresReplace) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// Modify requires replacement when the keepers change while avoid_previous is not known to be true.
+func (m integerKeepersRequiresReplace) Modify(ctx context.Context, req tfsdk.ModifyAttributePlanRequest, resp *tfsdk.ModifyAttributePlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.AttributePlan.Equal(req.AttributeState) {
+		return
+	}
+
+	var avoidPrevious types.Bool
+
+	diags := req.Config.GetAttribute(ctx, path.Root("avoid_previous"), &avoidPrevious)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.RequiresReplace = avoidPrevious.Unknown || !avoidPrevious.Value
+}
+
+// integerResultWithCheck returns the decimal representation of result followed by the check digit computed
+// with the given algorithm, or a null value when no check digit algorithm is configured.
+func integerResultWithCheck(result int64, checkDigit types.String) (types.String, error) {
+	var checkDigitFunc func(string) (byte, error)
+
+	switch checkDigit.Value {
+	case "luhn":
+		checkDigitFunc = random.LuhnCheckDigit
+	case "verhoeff":
+		checkDigitFunc = random.VerhoeffCheckDigit
+	default:
+		return types.String{Null: true}, nil
+	}
+
+	s := strconv.FormatInt(result, 10)
+
+	digit, err := checkDigitFunc(strings.TrimPrefix(s, "-"))
+	if err != nil {
+		return types.String{}, err
+	}
+
+	return types.String{Value: s + string(digit)}, nil
+}
+
+// integerFormat replaces the {result} and {padded} placeholders in template with result and padded. An error is
+// returned when template has no placeholders, references an unknown placeholder, or references {padded} while
+// padded is null.
+func integerFormat(template string, result int64, padded types.String) (string, error) {
+	var b strings.Builder
+	placeholders := 0
+
+	for rest := template; rest != ""; {
+		start := strings.IndexByte(rest, '{')
+		if start == -1 {
+			b.WriteString(rest)
+			break
+		}
+
+		end := strings.IndexByte(rest[start:], '}')
+		if end == -1 {
+			return "", fmt.Errorf("unterminated placeholder %q", rest[start:])
+		}
+
+		b.WriteString(rest[:start])
+
+		switch name := rest[start+1 : start+end]; name {
+		case "result":
+			b.WriteString(strconv.FormatInt(result, 10))
+		case "padded":
+			if padded.Null {
+				return "", errors.New("the {padded} placeholder requires pad_width to be set")
+			}
+			b.WriteString(padded.Value)
+		default:
+			return "", fmt.Errorf("unknown placeholder {%s}, expected {result} or {padded}", name)
+		}
+
+		placeholders++
+		rest = rest[start+end+1:]
+	}
+
+	if placeholders == 0 {
+		return "", errors.New("the template needs to reference at least one of {result} or {padded}")
+	}
+
+	return b.String(), nil
+}
+
+// integerRangeType is the type of the elements of the ranges attribute.
+var integerRangeType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"min": types.Int64Type,
+		"max": types.Int64Type,
+	},
+}
+
+// integerRange is an inclusive range of integers to draw from.
+type integerRange struct {
+	min, max int
+}
+
+// integerDraw returns a value drawn uniformly from the union of ranges, which must be sorted, non-overlapping, and
+// have a combined size that fits within an int.
+func integerDraw(rand *rand.Rand, ranges []integerRange) int {
+	var size int
+	for _, r := range ranges {
+		size += (r.max + 1) - r.min
+	}
+
+	return integerAt(rand.Intn(size), ranges)
+}
+
+// integerDigitBounds returns the smallest and the largest value that have exactly n digits in base, i.e. base^(n-1)
+// and base^n - 1. ok is false when base^n - 1 does not fit within a 64-bit integer.
+func integerDigitBounds(base, n int64) (lo, hi int64, ok bool) {
+	lo = 1
+	for i := int64(1); i < n; i++ {
+		if lo > math.MaxInt64/base {
+			return 0, 0, false
+		}
+
+		lo *= base
+	}
+
+	// base^n - 1 fits when base^n is at most 2^63, which is computed as an unsigned value.
+	if uint64(lo) > (1<<63)/uint64(base) {
+		return 0, 0, false
+	}
+
+	return lo, int64(uint64(lo)*uint64(base) - 1), true
+}
+
+// integerIntersect returns the parts of ranges that lie within bounds, dropping the ranges that lie outside of it.
+func integerIntersect(ranges []integerRange, bounds integerRange) []integerRange {
+	intersected := make([]integerRange, 0, len(ranges))
+
+	for _, r := range ranges {
+		if r.max < bounds.min || r.min > bounds.max {
+			continue
+		}
+
+		if r.min < bounds.min {
+			r.min = bounds.min
+		}
+		if r.max > bounds.max {
+			r.max = bounds.max
+		}
+
+		intersected = append(intersected, r)
+	}
+
+	return intersected
+}
+
+// integerExcluded returns the distinct values of exclude that lie within ranges.
+func integerExcluded(exclude types.List, ranges []integerRange) map[int]struct{} {
+	excluded := make(map[int]struct{}, len(exclude.Elems))
+
+	for _, v := range exclude.Elems {
+		n := int(v.(types.Int64).Value)
+		for _, r := range ranges {
+			if n >= r.min && n <= r.max {
+				excluded[n] = struct{}{}
+				break
+			}
+		}
+	}
+
+	return excluded
+}
+
+// integerDrawExcluding returns a value drawn uniformly from the union of ranges that is not in excluded, which
+// must only hold values within ranges and leave at least one value. While at least a tenth of the values remain,
+// integerDrawRejecting needs at most ten draws on average, no memory beyond excluded, and is all but certain to
+// succeed within random.MaxAttempts draws. Otherwise the whole range holds fewer than 10/9 as many values as
+// excluded, so integerDrawRemaining can list the remaining values cheaply, and always succeeds.
+func integerDrawExcluding(rand *rand.Rand, ranges []integerRange, excluded map[int]struct{}) int {
+	if len(excluded) == 0 {
+		return integerDraw(rand, ranges)
+	}
+
+	var size int
+	for _, r := range ranges {
+		size += (r.max + 1) - r.min
+	}
+
+	if size-len(excluded) >= size/10 {
+		if n, ok := integerDrawRejecting(rand, ranges, excluded); ok {
+			return n
+		}
+	}
+
+	return integerDrawRemaining(rand, ranges, excluded)
+}
+
+// integerTriangular returns a value between min and max inclusive, drawn from a triangular distribution peaked at
+// mode. A continuous value is drawn over [min, max + 1) with its peak in the middle of mode, following Stein and
+// Keblis, "A new method to simulate the triangular distribution", from two uniform draws u and v as
+// (1 - c) * min(u, v) + c * max(u, v), where c is the relative position of the peak, and is rounded down.
+func integerTriangular(rand *rand.Rand, min, max, mode int) int {
+	span := float64(uint64(max)-uint64(min)) + 1
+	c := (float64(uint64(mode)-uint64(min)) + 0.5) / span
+
+	u, v := rand.Float64(), rand.Float64()
+	if u > v {
+		u, v = v, u
+	}
+
+	offset := uint64(((1-c)*u + c*v) * span)
+	if offset > uint64(max)-uint64(min) {
+		offset = uint64(max) - uint64(min)
+	}
+
+	return int(uint64(min) + offset)
+}
+
+// integerDrawRejecting draws values from ranges until one is not in excluded, giving up after random.MaxAttempts
+// draws.
+func integerDrawRejecting(rand *rand.Rand, ranges []integerRange, excluded map[int]struct{}) (int, bool) {
+	for attempt := 0; attempt < random.MaxAttempts; attempt++ {
+		n := integerDraw(rand, ranges)
+		if _, ok := excluded[n]; !ok {
+			return n, true
+		}
+	}
+
+	return 0, false
+}
+
+// integerDrawRemaining lists every value of ranges that is not in excluded, and returns one of them chosen
+// uniformly.
+func integerDrawRemaining(rand *rand.Rand, ranges []integerRange, excluded map[int]struct{}) int {
+	var remaining []int
+
+	for _, r := range ranges {
+		for n := r.min; ; n++ {
+			if _, ok := excluded[n]; !ok {
+				remaining = append(remaining, n)
+			}
+
+			// Checking before incrementing avoids overflowing when r.max is the largest int.
+			if n == r.max {
+				break
+			}
+		}
+	}
+
+	return remaining[rand.Intn(len(remaining))]
+}
+
+// integerFromKey maps key onto ranges using the 64-bit FNV-1a hash of key, so that the same key and ranges always
+// produce the same value.
+func integerFromKey(key string, ranges []integerRange) int {
+	var size uint64
+	for _, r := range ranges {
+		size += uint64(r.max) - uint64(r.min) + 1
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+
+	return integerAt(int(h.Sum64()%size), ranges)
+}
+
+// integerAt returns the value at offset n within the union of ranges, counting from the minimum of the first range.
+func integerAt(n int, ranges []integerRange) int {
+	for _, r := range ranges {
+		rangeSize := (r.max + 1) - r.min
+		if n < rangeSize {
+			return n + r.min
+		}
+		n -= rangeSize
+	}
+
+	panic("unreachable")
+}
+
+// integerCongruence describes the values x within a set of ranges for which x mod modulus equals a residue, so that
+// they can be drawn without rejection. The values are numbered consecutively from 0, in ascending order.
+type integerCongruence struct {
+	modulus int
+
+	// positions holds the range of numbers of the values within each range that holds at least one of them, and
+	// firsts the lowest such value of that range.
+	positions []integerRange
+	firsts    []int
+}
+
+// newIntegerCongruence returns the congruence of the values within ranges, which must be sorted and non-overlapping,
+// that leave residue when divided by modulus. The remainder of negative values is also taken to be non-negative.
+// Differences are computed as unsigned values so that ranges spanning most of int64 do not overflow.
+func newIntegerCongruence(ranges []integerRange, modulus, residue int) integerCongruence {
+	c := integerCongruence{modulus: modulus}
+	next := 0
+
+	for _, r := range ranges {
+		rem := r.min % modulus
+		if rem < 0 {
+			rem += modulus
+		}
+
+		offset := residue - rem
+		if offset < 0 {
+			offset += modulus
+		}
+
+		span := uint64(r.max) - uint64(r.min)
+		if span < uint64(offset) {
+			continue
+		}
+
+		count := int((span-uint64(offset))/uint64(modulus)) + 1
+		c.positions = append(c.positions, integerRange{min: next, max: next + count - 1})
+		c.firsts = append(c.firsts, r.min+offset)
+		next += count
+	}
+
+	return c
+}
+
+// value returns the value numbered n.
+func (c integerCongruence) value(n int) int {
+	for i, p := range c.positions {
+		if n <= p.max {
+			return c.firsts[i] + (n-p.min)*c.modulus
+		}
+	}
+
+	panic("unreachable")
+}
+
+// excluded returns the numbers of the values in excluded that leave the residue, ignoring all other values.
+func (c integerCongruence) excluded(excluded map[int]struct{}) map[int]struct{} {
+	positions := make(map[int]struct{}, len(excluded))
+
+	for n := range excluded {
+		for i, p := range c.positions {
+			last := c.firsts[i] + (p.max-p.min)*c.modulus
+			if n < c.firsts[i] || n > last {
+				continue
+			}
+
+			if d := uint64(n) - uint64(c.firsts[i]); d%uint64(c.modulus) == 0 {
+				positions[p.min+int(d/uint64(c.modulus))] = struct{}{}
+			}
+			break
+		}
+	}
+
+	return positions
+}
+
+// integerResultsAttrs returns the elements of results and histogram for the given results.
+func integerResultsAttrs(results []int64) ([]attr.Value, map[string]attr.Value) {
+	elems := make([]attr.Value, 0, len(results))
+	counts := make(map[int64]int64, len(results))
+	for _, v := range results {
+		elems = append(elems, types.Int64{Value: v})
+		counts[v]++
+	}
+
+	histogram := make(map[string]attr.Value, len(counts))
+	for v, count := range counts {
+		histogram[strconv.FormatInt(v, 10)] = types.Int64{Value: count}
+	}
+
+	return elems, histogram
+}
+
+// integerSum returns the sum of results, and false when the sum does not fit within an int64. The sum is computed
+// exactly, so that a partial sum outside of int64 does not cause an error as long as the final sum fits.
+func integerSum(results []int64) (int64, bool) {
+	sum := new(big.Int)
+	for _, v := range results {
+		sum.Add(sum, big.NewInt(v))
+	}
+
+	return sum.Int64(), sum.IsInt64()
+}
+
+// integerProgression returns the count values of the arithmetic progression with the given difference, or of the
+// geometric progression with the given ratio, starting at start. An error is returned when a value does not fit
+// within an int64.
+func integerProgression(start, count int64, difference, ratio types.Int64) ([]int64, error) {
+	results := make([]int64, 1, count)
+	results[0] = start
+
+	for int64(len(results)) < count {
+		prev := results[len(results)-1]
+
+		var next int64
+		if !difference.Null {
+			d := difference.Value
+			if (d > 0 && prev > math.MaxInt64-d) || (d < 0 && prev < math.MinInt64-d) {
+				return nil, fmt.Errorf("value %d plus %d overflows", prev, d)
+			}
+			next = prev + d
+		} else {
+			r := ratio.Value
+			next = prev * r
+			if prev != 0 && (next/prev != r || (prev == -1 && r == math.MinInt64) || (r == -1 && prev == math.MinInt64)) {
+				return nil, fmt.Errorf("value %d times %d overflows", prev, r)
+			}
+		}
+
+		results = append(results, next)
+	}
+
+	return results, nil
+}
+
+// integerNormalized returns the position of result between min and max as a fraction, or 0 when min equals max.
+// Differences are computed as unsigned values so that ranges spanning most of int64 do not overflow.
+//
+// The fraction is returned as a number rather than a float64, as the framework rejects float64 values read back
+// from state that are not exactly representable in 64 bits.
+func integerNormalized(result, min, max int64) *big.Float {
+	if min == max {
+		return big.NewFloat(0)
+	}
+
+	return big.NewFloat(float64(uint64(result)-uint64(min)) / float64(uint64(max)-uint64(min)))
+}
+
+// integerPercentile returns the percentage of the values between min and max that are lower than result, which is
+// at least 0 and less than 100. It is returned as a number like integerNormalized.
+func integerPercentile(result, min, max int64) *big.Float {
+	p := float64(uint64(result)-uint64(min)) * 100 / (float64(uint64(max)-uint64(min)) + 1)
+
+	// Ranges wider than the precision of a float64 can round the percentile of max up to 100.
+	if p >= 100 {
+		p = math.Nextafter(100, 0)
+	}
+
+	return big.NewFloat(p)
+}
+
+// integerDistanceAtLeast reports whether candidate differs from every value in results by at least distance.
+// Differences are computed as unsigned values so that ranges spanning most of int64 do not overflow.
+func integerDistanceAtLeast(candidate int64, results []int64, distance int64) bool {
+	for _, v := range results {
+		d := uint64(candidate) - uint64(v)
+		if candidate < v {
+			d = uint64(v) - uint64(candidate)
+		}
+
+		if d < uint64(distance) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseIntegerImportPart parses a numeric part of an import ID as a decimal integer, or as a hexadecimal
+// integer when it is prefixed with 0x.
+func parseIntegerImportPart(part string) (int64, error) {
+	digits := strings.TrimLeft(part, "+-")
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		return strconv.ParseInt(part, 0, 64)
+	}
+
+	return strconv.ParseInt(part, 10, 64)
+}
+
 type integerModelV0 struct {
-	ID      types.String `tfsdk:"id"`
-	Keepers types.Map    `tfsdk:"keepers"`
-	Min     types.Int64  `tfsdk:"min"`
-	Max     types.Int64  `tfsdk:"max"`
-	Seed    types.String `tfsdk:"seed"`
-	Result  types.Int64  `tfsdk:"result"`
+	ID               types.String `tfsdk:"id"`
+	Keepers          types.Map    `tfsdk:"keepers"`
+	Min              types.Int64  `tfsdk:"min"`
+	Max              types.Int64  `tfsdk:"max"`
+	MinString        types.String `tfsdk:"min_string"`
+	MaxString        types.String `tfsdk:"max_string"`
+	Ranges           types.List   `tfsdk:"ranges"`
+	Seed             types.String `tfsdk:"seed"`
+	Salt             types.String `tfsdk:"salt"`
+	Key              types.String `tfsdk:"key"`
+	Exclude          types.List   `tfsdk:"exclude"`
+	Modulus          types.Int64  `tfsdk:"modulus"`
+	Residue          types.Int64  `tfsdk:"residue"`
+	Mode             types.Int64  `tfsdk:"mode"`
+	CheckDigit       types.String `tfsdk:"check_digit"`
+	Base             types.Int64  `tfsdk:"base"`
+	DigitCount       types.Int64  `tfsdk:"digit_count"`
+	PadWidth         types.Int64  `tfsdk:"pad_width"`
+	OutputTemplate   types.String `tfsdk:"output_template"`
+	ResultCount      types.Int64  `tfsdk:"result_count"`
+	MinDistance      types.Int64  `tfsdk:"min_distance"`
+	CommonDifference types.Int64  `tfsdk:"common_difference"`
+	CommonRatio      types.Int64  `tfsdk:"common_ratio"`
+	OneHotEncode     types.Bool   `tfsdk:"one_hot_encode"`
+	AvoidPrevious    types.Bool   `tfsdk:"avoid_previous"`
+	TimeBucket       types.String `tfsdk:"time_bucket"`
+	Result           types.Int64  `tfsdk:"result"`
+	Bucket           types.String `tfsdk:"bucket"`
+	Normalized       types.Number `tfsdk:"normalized"`
+	Percentile       types.Number `tfsdk:"percentile"`
+	Results          types.List   `tfsdk:"results"`
+	Histogram        types.Map    `tfsdk:"histogram"`
+	Sum              types.Int64  `tfsdk:"sum"`
+	MinValue         types.Int64  `tfsdk:"min_value"`
+	MaxValue         types.Int64  `tfsdk:"max_value"`
+	OneHot           types.List   `tfsdk:"one_hot"`
+	Encoded          types.String `tfsdk:"encoded"`
+	Padded           types.String `tfsdk:"padded"`
+	Formatted        types.String `tfsdk:"formatted"`
+	ResultWithCheck  types.String `tfsdk:"result_with_check"`
 }
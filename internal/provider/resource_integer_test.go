@@ -2,31 +2,1864 @@ package provider
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
-func TestAccResourceInteger(t *testing.T) {
+func TestAccResourceInteger(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min  = 1
+							max  = 3
+   							seed = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "3"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "normalized", "1"),
+				),
+			},
+			{
+				ResourceName:      "random_integer.integer_1",
+				ImportState:       true,
+				ImportStateId:     "3,1,3,12345",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_ImportHex(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min  = 16
+							max  = 255
+   							seed = "12345"
+						}`,
+			},
+			{
+				ResourceName: "random_integer.integer_1",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["random_integer.integer_1"]
+					result, err := strconv.ParseInt(rs.Primary.Attributes["result"], 10, 64)
+					if err != nil {
+						return "", err
+					}
+
+					return fmt.Sprintf("%#x,0x10,0XFF,12345", result), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_CheckDigit(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		checkDigit      string
+		resultWithCheck string
+	}{
+		{
+			name:            "luhn",
+			checkDigit:      "luhn",
+			resultWithCheck: "34",
+		},
+		{
+			name:            "verhoeff",
+			checkDigit:      "verhoeff",
+			resultWithCheck: "36",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`resource "random_integer" "integer_1" {
+   							min         = 1
+							max         = 3
+   							seed        = "12345"
+							check_digit = %q
+						}`, c.checkDigit),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("random_integer.integer_1", "result", "3"),
+							resource.TestCheckResourceAttr("random_integer.integer_1", "result_with_check", c.resultWithCheck),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccResourceInteger_CheckDigitNone(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min         = 1
+							max         = 3
+							check_digit = "none"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("random_integer.integer_1", "result_with_check"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_CheckDigitErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min         = 1
+							max         = 3
+							check_digit = "damm"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be one of: .*got: "damm"`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Padded(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		min      int64
+		max      int64
+		padWidth int64
+		padded   string
+	}{
+		{
+			name:     "positive",
+			min:      42,
+			max:      42,
+			padWidth: 5,
+			padded:   "00042",
+		},
+		{
+			name:     "negative",
+			min:      -42,
+			max:      -42,
+			padWidth: 5,
+			padded:   "-0042",
+		},
+		{
+			name:     "exact width",
+			min:      123,
+			max:      123,
+			padWidth: 3,
+			padded:   "123",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`resource "random_integer" "integer_1" {
+   							min       = %d
+							max       = %d
+							pad_width = %d
+						}`, c.min, c.max, c.padWidth),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("random_integer.integer_1", "result", strconv.FormatInt(c.min, 10)),
+							resource.TestCheckResourceAttr("random_integer.integer_1", "id", strconv.FormatInt(c.min, 10)),
+							resource.TestCheckResourceAttr("random_integer.integer_1", "padded", c.padded),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccResourceInteger_PaddedErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min       = -100
+							max       = 5
+							pad_width = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*The pad width \(pad_width\) value needs to be greater than or equal to the\nnumber of characters in both the minimum \(min\) and maximum \(max\) values.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min       = 1
+							max       = 5
+							pad_width = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 1 and 64, got: 0`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min       = 1
+							max       = 5
+							pad_width = 65
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 1 and 64, got: 65`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_OutputTemplate(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min             = 1
+							max             = 3
+							pad_width       = 5
+							output_template = "SRV-{padded}-X ({result})"
+   							seed            = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "formatted", "SRV-00003-X (3)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_OutputTemplateErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min             = 1
+							max             = 3
+							output_template = "SRV-{padded}"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The output template \(output_template\) value is invalid: the {padded}\nplaceholder requires pad_width to be set.`),
+			},
+		},
+	})
+}
+
+func TestIntegerFormat(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		template    string
+		padded      types.String
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "result",
+			template: "{result}",
+			padded:   types.String{Null: true},
+			expected: "42",
+		},
+		{
+			name:     "padded and result",
+			template: "SRV-{padded}-X/{result}",
+			padded:   types.String{Value: "00042"},
+			expected: "SRV-00042-X/42",
+		},
+		{
+			name:        "no placeholders",
+			template:    "SRV",
+			padded:      types.String{Null: true},
+			expectedErr: "the template needs to reference at least one of {result} or {padded}",
+		},
+		{
+			name:        "unknown placeholder",
+			template:    "{result}-{hex}",
+			padded:      types.String{Null: true},
+			expectedErr: "unknown placeholder {hex}, expected {result} or {padded}",
+		},
+		{
+			name:        "unterminated placeholder",
+			template:    "{result",
+			padded:      types.String{Null: true},
+			expectedErr: `unterminated placeholder "{result"`,
+		},
+		{
+			name:        "padded without pad_width",
+			template:    "{padded}",
+			padded:      types.String{Null: true},
+			expectedErr: "the {padded} placeholder requires pad_width to be set",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := integerFormat(c.template, 42, c.padded)
+
+			if c.expectedErr != "" {
+				if err == nil || err.Error() != c.expectedErr {
+					t.Fatalf("expected error %q, got %v", c.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestAccResourceInteger_ResultCount(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min          = 1
+							max          = 3
+							result_count = 4
+   							seed         = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "3"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "results.#", "4"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "results.0", "3"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "results.1", "2"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "results.2", "2"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "results.3", "2"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "histogram.%", "2"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "histogram.2", "3"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "histogram.3", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Progression(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "arithmetic" {
+							min               = 0
+							max               = 59
+							result_count      = 4
+							common_difference = 15
+							seed              = "12345"
+						}
+						resource "random_integer" "geometric" {
+							min          = -3
+							max          = -1
+							result_count = 4
+							common_ratio = -2
+							seed         = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.arithmetic", "result", "23"),
+					resource.TestCheckResourceAttr("random_integer.arithmetic", "results.#", "4"),
+					resource.TestCheckResourceAttr("random_integer.arithmetic", "results.3", "68"),
+					resource.TestCheckResourceAttr("random_integer.geometric", "result", "-1"),
+					resource.TestCheckResourceAttr("random_integer.geometric", "results.3", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_ResultCountErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min          = 1
+							max          = 3
+							result_count = 10001
+						}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*Value must be between 1 and 10000, got: 10001`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Mode(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min          = 1
+							max          = 100
+							mode         = 90
+							result_count = 3
+							seed         = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "mode", "90"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "83"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "results.2", "88"),
+				),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min  = 1
+							max  = 100
+							mode = 101
+						}`,
+				ExpectError: regexp.MustCompile(`.*The mode value needs to be between the minimum \(min\) and maximum \(max\)\nvalues.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min     = 1
+							max     = 100
+							mode    = 50
+							exclude = [50]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "exclude" cannot be specified when "mode" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Aggregates(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "geometric" {
+							min          = -3
+							max          = -1
+							result_count = 4
+							common_ratio = -2
+							seed         = "12345"
+						}
+						resource "random_integer" "single" {
+							min = 1
+							max = 10
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.geometric", "sum", "5"),
+					resource.TestCheckResourceAttr("random_integer.geometric", "min_value", "-4"),
+					resource.TestCheckResourceAttr("random_integer.geometric", "max_value", "8"),
+					resource.TestCheckNoResourceAttr("random_integer.single", "sum"),
+					resource.TestCheckNoResourceAttr("random_integer.single", "min_value"),
+					resource.TestCheckNoResourceAttr("random_integer.single", "max_value"),
+				),
+			},
+			{
+				Config: `resource "random_integer" "overflow" {
+							min          = 4611686018427387904
+							max          = 9223372036854775807
+							result_count = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*The sum of the 3 values in results does not fit within a 64-bit integer`),
+			},
+		},
+	})
+}
+
+func TestIntegerDigitBounds(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		base, n int64
+		wantLo  int64
+		wantHi  int64
+		wantOk  bool
+	}{
+		"single digit": {
+			base:   10,
+			n:      1,
+			wantLo: 1,
+			wantHi: 9,
+			wantOk: true,
+		},
+		"base 36": {
+			base:   36,
+			n:      4,
+			wantLo: 46656,
+			wantHi: 1679615,
+			wantOk: true,
+		},
+		"largest": {
+			base:   2,
+			n:      63,
+			wantLo: 1 << 62,
+			wantHi: math.MaxInt64,
+			wantOk: true,
+		},
+		"overflow": {
+			base:   2,
+			n:      64,
+			wantOk: false,
+		},
+		"lower bound overflow": {
+			base:   36,
+			n:      14,
+			wantOk: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lo, hi, ok := integerDigitBounds(testCase.base, testCase.n)
+			if ok != testCase.wantOk {
+				t.Fatalf("expected ok %t, got %t", testCase.wantOk, ok)
+			}
+
+			if ok && (lo != testCase.wantLo || hi != testCase.wantHi) {
+				t.Errorf("expected %d-%d, got %d-%d", testCase.wantLo, testCase.wantHi, lo, hi)
+			}
+		})
+	}
+}
+
+func TestIntegerTimeBucketStart(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		now        time.Time
+		timeBucket types.String
+		expected   types.String
+	}{
+		"hour": {
+			now:        time.Date(2022, 7, 6, 13, 20, 5, 0, time.UTC),
+			timeBucket: types.String{Value: "hour"},
+			expected:   types.String{Value: "2022-07-06T13:00:00Z"},
+		},
+		"day": {
+			now:        time.Date(2022, 7, 6, 13, 20, 5, 0, time.UTC),
+			timeBucket: types.String{Value: "day"},
+			expected:   types.String{Value: "2022-07-06T00:00:00Z"},
+		},
+		"week on wednesday": {
+			now:        time.Date(2022, 7, 6, 13, 20, 5, 0, time.UTC),
+			timeBucket: types.String{Value: "week"},
+			expected:   types.String{Value: "2022-07-04T00:00:00Z"},
+		},
+		"week on monday": {
+			now:        time.Date(2022, 7, 4, 0, 0, 0, 0, time.UTC),
+			timeBucket: types.String{Value: "week"},
+			expected:   types.String{Value: "2022-07-04T00:00:00Z"},
+		},
+		"week on sunday": {
+			now:        time.Date(2022, 7, 3, 23, 59, 59, 0, time.UTC),
+			timeBucket: types.String{Value: "week"},
+			expected:   types.String{Value: "2022-06-27T00:00:00Z"},
+		},
+		"other time zone": {
+			now:        time.Date(2022, 7, 6, 1, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+			timeBucket: types.String{Value: "day"},
+			expected:   types.String{Value: "2022-07-05T00:00:00Z"},
+		},
+		"null": {
+			now:        time.Date(2022, 7, 6, 13, 20, 5, 0, time.UTC),
+			timeBucket: types.String{Null: true},
+			expected:   types.String{Null: true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := integerTimeBucketStart(testCase.now, testCase.timeBucket)
+			if !actual.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIntegerPercentile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		result, min, max int64
+		expected         float64
+	}{
+		"single value": {
+			result:   5,
+			min:      5,
+			max:      5,
+			expected: 0,
+		},
+		"lowest": {
+			result:   -10,
+			min:      -10,
+			max:      9,
+			expected: 0,
+		},
+		"quarter": {
+			result:   -5,
+			min:      -10,
+			max:      9,
+			expected: 25,
+		},
+		"highest": {
+			result:   9,
+			min:      -10,
+			max:      9,
+			expected: 95,
+		},
+		"full range": {
+			result:   math.MaxInt64,
+			min:      math.MinInt64,
+			max:      math.MaxInt64,
+			expected: math.Nextafter(100, 0),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, _ := integerPercentile(testCase.result, testCase.min, testCase.max).Float64()
+			if actual != testCase.expected {
+				t.Errorf("expected %v, got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIntegerSum(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		results []int64
+		want    int64
+		wantOk  bool
+	}{
+		"small": {
+			results: []int64{-5, 3, 10},
+			want:    8,
+			wantOk:  true,
+		},
+		"partial overflow": {
+			results: []int64{math.MaxInt64, 1, -2},
+			want:    math.MaxInt64 - 1,
+			wantOk:  true,
+		},
+		"overflow": {
+			results: []int64{math.MaxInt64, 1},
+			wantOk:  false,
+		},
+		"underflow": {
+			results: []int64{math.MinInt64, -1},
+			wantOk:  false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := integerSum(testCase.results)
+			if ok != testCase.wantOk {
+				t.Fatalf("expected ok %t, got %t", testCase.wantOk, ok)
+			}
+
+			if ok && got != testCase.want {
+				t.Errorf("expected %d, got %d", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestAccResourceInteger_ProgressionErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min               = 1
+							max               = 9223372036854775800
+							result_count      = 3
+							common_difference = 4
+						}`,
+				ExpectError: regexp.MustCompile(`.*The progression of 3 values starting at 9223372036854775800 does not fit\nwithin a 64-bit integer`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min          = 1
+							max          = 10
+							result_count = 64
+							common_ratio = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*The progression of 64 values starting at 1 does not fit within a 64-bit\ninteger`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min          = 1
+							max          = 10
+							result_count = 3
+							common_ratio = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*The common_ratio value needs to be non-zero.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min               = 1
+							max               = 10
+							common_difference = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "result_count" must be specified when "common_difference" is\nspecified`),
+			},
+		},
+	})
+}
+
+func TestIntegerProgression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		start      int64
+		difference types.Int64
+		ratio      types.Int64
+		want       []int64
+		wantErr    bool
+	}{
+		"arithmetic": {
+			start:      -5,
+			difference: types.Int64{Value: 3},
+			ratio:      types.Int64{Null: true},
+			want:       []int64{-5, -2, 1, 4},
+		},
+		"arithmetic underflow": {
+			start:      math.MinInt64 + 5,
+			difference: types.Int64{Value: -2},
+			ratio:      types.Int64{Null: true},
+			wantErr:    true,
+		},
+		"geometric": {
+			start:      3,
+			difference: types.Int64{Null: true},
+			ratio:      types.Int64{Value: -10},
+			want:       []int64{3, -30, 300, -3000},
+		},
+		"geometric overflow": {
+			start:      math.MinInt64,
+			difference: types.Int64{Null: true},
+			ratio:      types.Int64{Value: -1},
+			wantErr:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := integerProgression(testCase.start, 4, testCase.difference, testCase.ratio)
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(testCase.want) {
+				t.Errorf("expected %v, got %v", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestAccResourceInteger_MinDistance(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min          = 1
+							max          = 100
+							result_count = 5
+							min_distance = 10
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "results.#", "5"),
+					testAccResourceIntegerCheckMinDistance("random_integer.integer_1", 10),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_MinDistanceErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min          = 1
+							max          = 10
+							result_count = 5
+							min_distance = 5
+						}`,
+				ExpectError: regexp.MustCompile(`.*The range from min to max cannot accommodate 5 values that are at least 5\napart \(min_distance\).`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+   							min          = 1
+							max          = 10
+							min_distance = 5
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "result_count" must be specified when "min_distance" is specified`),
+			},
+		},
+	})
+}
+
+func TestIntegerDraw_Uniform(t *testing.T) {
+	t.Parallel()
+
+	rand := random.NewRand("12345")
+
+	samples := make([]int, 100000)
+	for i := range samples {
+		v := integerDraw(rand, []integerRange{{min: -5, max: 4}})
+		if v < -5 || v > 4 {
+			t.Fatalf("expected value between -5 and 4, got %d", v)
+		}
+
+		samples[i] = v + 5
+	}
+
+	// 27.88 is the critical value for 9 degrees of freedom at a significance level of 0.001.
+	if statistic := random.ChiSquareUniform(samples, 10); statistic > 27.88 {
+		t.Errorf("expected chi-square statistic of at most 27.88, got %f", statistic)
+	}
+}
+
+func TestIntegerTriangular(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		min, max, mode int
+	}{
+		"peak inside":  {min: 1, max: 10, mode: 8},
+		"peak at min":  {min: -5, max: 4, mode: -5},
+		"peak at max":  {min: 0, max: 9, mode: 9},
+		"single value": {min: 3, max: 3, mode: 3},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rand := random.NewRand("12345")
+			span := float64(testCase.max-testCase.min) + 1
+			peak := float64(testCase.mode-testCase.min) + 0.5
+
+			// cdf is the distribution function of the continuous triangular distribution over [0, span).
+			cdf := func(x float64) float64 {
+				if x <= peak {
+					return x * x / (span * peak)
+				}
+				return 1 - (span-x)*(span-x)/(span*(span-peak))
+			}
+
+			const draws = 100000
+			counts := make(map[int]int)
+			for i := 0; i < draws; i++ {
+				v := integerTriangular(rand, testCase.min, testCase.max, testCase.mode)
+				if v < testCase.min || v > testCase.max {
+					t.Fatalf("expected value between %d and %d, got %d", testCase.min, testCase.max, v)
+				}
+				counts[v]++
+			}
+
+			for v := testCase.min; v <= testCase.max; v++ {
+				offset := float64(v - testCase.min)
+				want := cdf(offset+1) - cdf(offset)
+				if got := float64(counts[v]) / draws; math.Abs(got-want) > 0.005 {
+					t.Errorf("expected share of %d to be %f, got %f", v, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestIntegerDrawExcluding(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ranges  []integerRange
+		exclude []int
+	}{
+		"sparse": {
+			ranges:  []integerRange{{min: 0, max: 9}, {min: 20, max: 29}},
+			exclude: []int{0, 5, 25},
+		},
+		"dense": {
+			ranges:  []integerRange{{min: 0, max: 19}},
+			exclude: []int{0, 1, 2, 3, 4, 5, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			excluded := make(map[int]struct{}, len(testCase.exclude))
+			for _, v := range testCase.exclude {
+				excluded[v] = struct{}{}
+			}
+
+			rand := random.NewRand("12345")
+
+			for i := 0; i < 1000; i++ {
+				v := integerDrawExcluding(rand, testCase.ranges, excluded)
+				if _, ok := excluded[v]; ok {
+					t.Fatalf("expected value not in %v, got %d", testCase.exclude, v)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkIntegerDrawExcluding compares both strategies of integerDrawExcluding on a range with few exclusions,
+// where integerDrawRejecting is used, and on a range in which most values are excluded, where
+// integerDrawRemaining is used.
+func BenchmarkIntegerDrawExcluding(b *testing.B) {
+	benchmarks := map[string]struct {
+		ranges   []integerRange
+		excluded int
+	}{
+		"sparse": {
+			ranges:   []integerRange{{min: 0, max: 1_000_000}},
+			excluded: 1_000,
+		},
+		"dense": {
+			ranges:   []integerRange{{min: 0, max: 1_000}},
+			excluded: 990,
+		},
+	}
+
+	strategies := map[string]func(rand *rand.Rand, ranges []integerRange, excluded map[int]struct{}) int{
+		"rejecting": func(rand *rand.Rand, ranges []integerRange, excluded map[int]struct{}) int {
+			n, _ := integerDrawRejecting(rand, ranges, excluded)
+			return n
+		},
+		"remaining": integerDrawRemaining,
+	}
+
+	for name, benchmark := range benchmarks {
+		excluded := make(map[int]struct{}, benchmark.excluded)
+		for i := 0; i < benchmark.excluded; i++ {
+			excluded[i] = struct{}{}
+		}
+
+		for strategy, draw := range strategies {
+			b.Run(name+"/"+strategy, func(b *testing.B) {
+				rand := random.NewRand("12345")
+
+				for i := 0; i < b.N; i++ {
+					draw(rand, benchmark.ranges, excluded)
+				}
+			})
+		}
+	}
+}
+
+func TestAccResourceInteger_Exclude(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "dense" {
+							min     = 1
+							max     = 3
+							exclude = [1, 2, 99]
+						}
+						resource "random_integer" "sparse" {
+							min          = 1
+							max          = 10
+							exclude      = [5]
+							result_count = 50
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.dense", "result", "3"),
+					resource.TestCheckNoResourceAttr("random_integer.sparse", "histogram.5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_ExcludeComputed(t *testing.T) {
+	t.Parallel()
+
+	var a, b string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// The results of a and b are unknown until apply, so every value of the pool is drawn once.
+				Config: `resource "random_integer" "a" {
+							min = 1
+							max = 3
+						}
+						resource "random_integer" "b" {
+							min     = 1
+							max     = 3
+							exclude = [random_integer.a.result]
+						}
+						resource "random_integer" "c" {
+							min     = 1
+							max     = 3
+							exclude = [random_integer.a.result, random_integer.b.result]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttrCapture("random_integer.a", "result", &a),
+					testAccCheckAttrCapture("random_integer.b", "result", &b),
+					testAccCheckAttrChanged("random_integer.b", "result", &a),
+					testAccCheckAttrChanged("random_integer.c", "result", &a),
+					testAccCheckAttrChanged("random_integer.c", "result", &b),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_AvoidPrevious(t *testing.T) {
+	t.Parallel()
+
+	var first, second string
+
+	config := func(keeper string) string {
+		return fmt.Sprintf(`resource "random_integer" "integer_1" {
+							min            = 1
+							max            = 3
+							exclude        = [3]
+							avoid_previous = true
+							keepers = {
+								rotation = %q
+							}
+						}`, keeper)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("a"),
+				Check:  testAccCheckAttrCapture("random_integer.integer_1", "result", &first),
+			},
+			{
+				// Only 1 and 2 can be drawn, so avoiding the previous result alternates between them.
+				Config: config("b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttrChanged("random_integer.integer_1", "result", &first),
+					testAccCheckAttrCapture("random_integer.integer_1", "result", &second),
+				),
+			},
+			{
+				Config: config("c"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttrChanged("random_integer.integer_1", "result", &second),
+					testAccCheckAttrEquals("random_integer.integer_1", "result", &first),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_AvoidPreviousErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min            = 1
+							max            = 5
+							key            = "tenant"
+							avoid_previous = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "key" cannot be specified when "avoid_previous" is specified`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min            = 1
+							max            = 1
+							avoid_previous = true
+							keepers = {
+								rotation = "a"
+							}
+						}`,
+				Check: resource.TestCheckResourceAttr("random_integer.integer_1", "result", "1"),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min            = 1
+							max            = 1
+							avoid_previous = true
+							keepers = {
+								rotation = "b"
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`(?s)Update Random Integer Error.*The previous result is the only value in the range that is not excluded`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_ExcludeErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min     = 1
+							max     = 2
+							exclude = [1, 2]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Every value in the range is excluded \(exclude\). At least one value needs to\nremain.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min     = 1
+							max     = 2
+							exclude = [1, null]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The exclude list needs to contain numbers only, got null at index 1.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min     = 1
+							max     = 10
+							key     = "tenant-42"
+							exclude = [2]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "exclude" cannot be specified when "key" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Modulus(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "seeded" {
+							min          = 0
+							max          = 100
+							modulus      = 7
+							residue      = 3
+							result_count = 20
+							seed         = "12345"
+						}
+						resource "random_integer" "negative" {
+							min     = -20
+							max     = -1
+							modulus = 5
+							residue = 2
+							exclude = [-18, -13, -8, -7]
+						}
+						resource "random_integer" "ranges" {
+							ranges = [
+								{ min = 1000, max = 1999 },
+								{ min = -9, max = 9 },
+							]
+							modulus      = 1000
+							residue      = 4
+							result_count = 20
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.seeded", "result", "24"),
+					testAccResourceIntegerCheckModulus("random_integer.seeded", 7, 3),
+					resource.TestCheckResourceAttr("random_integer.negative", "result", "-3"),
+					testAccResourceIntegerCheckModulus("random_integer.ranges", 1000, 4),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_ModulusErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min     = 1
+							max     = 100
+							modulus = 7
+							residue = 7
+						}`,
+				ExpectError: regexp.MustCompile(`.*The residue value needs to be smaller than the modulus value.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min     = 4
+							max     = 9
+							modulus = 7
+							residue = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*No value in the range leaves a remainder of 3 when divided by 7`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min     = 1
+							max     = 100
+							modulus = 7
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "residue" must be specified when "modulus" is specified`),
+			},
+		},
+	})
+}
+
+func TestIntegerCongruence(t *testing.T) {
+	t.Parallel()
+
+	ranges := []integerRange{{min: -10, max: -1}, {min: 2, max: 4}, {min: 5, max: 20}}
+	c := newIntegerCongruence(ranges, 4, 1)
+
+	var want []int
+	for _, r := range ranges {
+		for n := r.min; n <= r.max; n++ {
+			if (n%4+4)%4 == 1 {
+				want = append(want, n)
+			}
+		}
+	}
+
+	if len(c.positions) != 2 {
+		t.Fatalf("expected the range without values to be dropped, got positions %v", c.positions)
+	}
+
+	for i, v := range want {
+		if got := c.value(i); got != v {
+			t.Errorf("expected value %d at position %d, got %d", v, i, got)
+		}
+	}
+
+	excluded := c.excluded(map[int]struct{}{-7: {}, -6: {}, 13: {}, 99: {}})
+	if len(excluded) != 2 {
+		t.Fatalf("expected 2 excluded positions, got %v", excluded)
+	}
+
+	for _, n := range []int{0, 4} {
+		if _, ok := excluded[n]; !ok {
+			t.Errorf("expected position %d to be excluded, got %v", n, excluded)
+		}
+	}
+}
+
+func TestAccResourceInteger_Ranges(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							ranges = [
+								{ min = 300, max = 399 },
+								{ min = 100, max = 199 },
+							]
+							result_count = 20
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("random_integer.integer_1", "min"),
+					resource.TestCheckNoResourceAttr("random_integer.integer_1", "max"),
+					testAccResourceIntegerCheckRanges("random_integer.integer_1", []integerRange{{min: 100, max: 199}, {min: 300, max: 399}}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_DigitCount(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "base36" {
+							min          = 0
+							max          = 9999999
+							base         = 36
+							digit_count  = 4
+							result_count = 20
+						}
+						resource "random_integer" "intersected" {
+							min          = 0
+							max          = 50000
+							base         = 36
+							digit_count  = 4
+							result_count = 20
+						}
+						resource "random_integer" "ranges" {
+							ranges = [
+								{ min = 0, max = 9 },
+								{ min = 90, max = 120 },
+							]
+							base         = 10
+							digit_count  = 2
+							result_count = 20
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_integer.base36", "encoded", regexp.MustCompile(`^[1-9a-z][0-9a-z]{3}$`)),
+					testAccResourceIntegerCheckRanges("random_integer.base36", []integerRange{{min: 46656, max: 1679615}}),
+					testAccResourceIntegerCheckRanges("random_integer.intersected", []integerRange{{min: 46656, max: 50000}}),
+					testAccResourceIntegerCheckRanges("random_integer.ranges", []integerRange{{min: 90, max: 99}}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Base(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min  = 255
+							max  = 255
+							base = 16
+						}
+						resource "random_integer" "integer_2" {
+							min = 1
+							max = 3
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "encoded", "ff"),
+					resource.TestCheckNoResourceAttr("random_integer.integer_2", "encoded"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_DigitCountErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min         = 1
+							max         = 10
+							base        = 10
+							digit_count = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*No value in the range has exactly 3 digits in base 10 \(digit_count and base\),\ni.e. lies between 100 and 999.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min         = 1
+							max         = 10
+							base        = 36
+							digit_count = 13
+						}`,
+				ExpectError: regexp.MustCompile(`.*The values with 13 digits in base 36 do not fit within a 64-bit integer.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min         = 1
+							max         = 10
+							digit_count = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "base" must be specified when "digit_count" is specified`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min  = 1
+							max  = 10
+							base = 37
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 2 and 36, got: 37`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_TimeBucket(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 7, 6, 13, 20, 0, 0, time.UTC)
+	config := `resource "random_integer" "hourly" {
+					min         = 1
+					max         = 1000000
+					seed        = "shard"
+					time_bucket = "hour"
+				}
+				resource "random_integer" "hourly_copy" {
+					min         = 1
+					max         = 1000000
+					seed        = "shard"
+					time_bucket = "hour"
+				}
+				resource "random_integer" "daily" {
+					min         = 1
+					max         = 1000000
+					time_bucket = "day"
+				}
+				resource "random_integer" "weekly" {
+					min         = 1
+					max         = 1000000
+					time_bucket = "week"
+				}`
+
+	var hourly, daily, weekly string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: clockProviderFactories(func() time.Time { return now }),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.hourly", "bucket", "2022-07-06T13:00:00Z"),
+					resource.TestCheckResourceAttr("random_integer.daily", "bucket", "2022-07-06T00:00:00Z"),
+					resource.TestCheckResourceAttr("random_integer.weekly", "bucket", "2022-07-04T00:00:00Z"),
+					resource.TestCheckResourceAttrPair("random_integer.hourly", "result", "random_integer.hourly_copy", "result"),
+					testAccCheckAttrCapture("random_integer.hourly", "result", &hourly),
+					testAccCheckAttrCapture("random_integer.daily", "result", &daily),
+					testAccCheckAttrCapture("random_integer.weekly", "result", &weekly),
+				),
+			},
+			{
+				PreConfig: func() { now = time.Date(2022, 7, 6, 13, 59, 59, 0, time.UTC) },
+				Config:    config,
+				PlanOnly:  true,
+			},
+			{
+				PreConfig: func() { now = time.Date(2022, 7, 6, 14, 0, 0, 0, time.UTC) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.hourly", "bucket", "2022-07-06T14:00:00Z"),
+					resource.TestCheckResourceAttrPair("random_integer.hourly", "result", "random_integer.hourly_copy", "result"),
+					testAccCheckAttrChanged("random_integer.hourly", "result", &hourly),
+					testAccCheckAttrEquals("random_integer.daily", "result", &daily),
+					testAccCheckAttrEquals("random_integer.weekly", "result", &weekly),
+				),
+			},
+			{
+				PreConfig: func() { now = time.Date(2022, 7, 11, 0, 0, 0, 0, time.UTC) },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.daily", "bucket", "2022-07-11T00:00:00Z"),
+					resource.TestCheckResourceAttr("random_integer.weekly", "bucket", "2022-07-11T00:00:00Z"),
+					testAccCheckAttrChanged("random_integer.daily", "result", &daily),
+					testAccCheckAttrChanged("random_integer.weekly", "result", &weekly),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_TimeBucketErrors(t *testing.T) {
 	t.Parallel()
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_integer" "integer_1" {
-   							min  = 1
-							max  = 3
-   							seed = "12345"
+							min         = 1
+							max         = 10
+							time_bucket = "month"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be one of: \["\\"hour\\"" "\\"day\\"" "\\"week\\""\], got: "month"`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min         = 1
+							max         = 10
+							key         = "tenant"
+							time_bucket = "day"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "key" cannot be specified when "time_bucket" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Key(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "shard_1" {
+							min = 0
+							max = 15
+							key = "tenant-42"
+						}
+
+						resource "random_integer" "shard_2" {
+							min = 0
+							max = 15
+							key = "tenant-7"
+						}
+
+						resource "random_integer" "shard_3" {
+							ranges = [
+								{ min = 300, max = 399 },
+								{ min = 100, max = 199 },
+							]
+							key = "tenant-7"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.shard_1", "result", "2"),
+					resource.TestCheckResourceAttr("random_integer.shard_2", "result", "13"),
+					resource.TestCheckResourceAttr("random_integer.shard_3", "result", "397"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_KeyErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "shard" {
+							min  = 0
+							max  = 15
+							key  = "tenant-42"
+							seed = "12345"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "seed" cannot be specified when "key" is specified`),
+			},
+			{
+				Config: `resource "random_integer" "shard" {
+							min          = 0
+							max          = 15
+							key          = "tenant-42"
+							result_count = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "result_count" cannot be specified when "key" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_RangesSeed(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							ranges = [
+								{ min = 1, max = 3 },
+							]
+							seed = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					// A single range draws the same values as the equivalent min and max.
+					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Normalized(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min = 5
+							max = 5
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "5"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "normalized", "0"),
+				),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min = -10
+							max = 10
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceIntegerCheckNormalized("random_integer.integer_1", -10, 10),
+				),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							ranges = [
+								{ min = 0, max = 1 },
+								{ min = 9, max = 10 },
+							]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceIntegerCheckNormalized("random_integer.integer_1", 0, 10),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Percentile(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "single" {
+							min = 7
+							max = 7
+						}
+						resource "random_integer" "lowest" {
+							min     = 0
+							max     = 3
+							exclude = [1, 2, 3]
+						}
+						resource "random_integer" "highest" {
+							min     = 0
+							max     = 3
+							exclude = [0, 1, 2]
+						}
+						resource "random_integer" "below_boundary" {
+							min     = 0
+							max     = 99
+							exclude = [for i in range(100) : i if i != 24]
+						}
+						resource "random_integer" "at_boundary" {
+							min     = 0
+							max     = 99
+							exclude = [for i in range(100) : i if i != 25]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.single", "percentile", "0"),
+					resource.TestCheckResourceAttr("random_integer.lowest", "percentile", "0"),
+					resource.TestCheckResourceAttr("random_integer.highest", "percentile", "75"),
+					resource.TestCheckResourceAttr("random_integer.below_boundary", "percentile", "24"),
+					resource.TestCheckResourceAttr("random_integer.at_boundary", "percentile", "25"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_MinMaxString(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min_string = "1"
+							max_string = "3"
+							seed       = "12345"
 						}`,
 				Check: resource.ComposeTestCheckFunc(
+					// The string bounds draw the same values as the equivalent min and max.
 					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "3"),
+					resource.TestCheckNoResourceAttr("random_integer.integer_1", "min"),
+					resource.TestCheckNoResourceAttr("random_integer.integer_1", "max"),
 				),
 			},
+		},
+	})
+}
+
+func TestAccResourceInteger_MinMaxStringErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
 			{
-				ResourceName:      "random_integer.integer_1",
-				ImportState:       true,
-				ImportStateId:     "3,1,3,12345",
-				ImportStateVerify: true,
+				Config: `resource "random_integer" "integer_1" {
+							min_string = "one"
+							max_string = "3"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The min_string value "one" could not be parsed as a 64-bit integer.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min_string = "1"
+							max_string = "99999999999999999999"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The max_string value "99999999999999999999" could not be parsed as a 64-bit\ninteger.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min        = 1
+							max        = 3
+							min_string = "1"
+							max_string = "3"
+						}`,
+				ExpectError: regexp.MustCompile(`.*2 attributes specified when one \(and only one\) of`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min_string = "1"
+							max        = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "max_string" must be specified when "min_string" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_OneHot(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min            = 1
+							max            = 3
+							seed           = "12345"
+							one_hot_encode = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_integer.integer_1", "result", "3"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "one_hot.#", "3"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "one_hot.0", "false"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "one_hot.1", "false"),
+					resource.TestCheckResourceAttr("random_integer.integer_1", "one_hot.2", "true"),
+				),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min  = 1
+							max  = 3
+							seed = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("random_integer.integer_1", "one_hot.#"),
+				),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min            = 0
+							max            = 1024
+							one_hot_encode = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*The range from min to max needs to contain at most 1024 values when\none_hot_encode is true.`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_RangesErrors(t *testing.T) {
+	t.Parallel()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "integer_1" {
+							ranges = [
+								{ min = 100, max = 199 },
+								{ min = 150, max = 250 },
+							]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The ranges 100-199 and 150-250 overlap. Ranges need to be non-overlapping.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							ranges = [
+								{ min = 5, max = 1 },
+							]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The minimum \(min\) value needs to be smaller than or equal to maximum \(max\)\nvalue.`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min = 1
+							max = 5
+							ranges = [
+								{ min = 1, max = 5 },
+							]
+						}`,
+				ExpectError: regexp.MustCompile(`.*2 attributes specified when one \(and only one\) of`),
+			},
+			{
+				Config: `resource "random_integer" "integer_1" {
+							min = 1
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "max" must be specified when "min" is specified`),
 			},
 		},
 	})
@@ -117,6 +1950,44 @@ func TestAccResourceInteger_SeededToSeedless(t *testing.T) {
 	})
 }
 
+// TestAccResourceInteger_StableAcrossPlanAndApply checks that a seedless result, whose keepers are only known
+// after the resource they refer to has been applied, is generated once and then retained by every later plan
+// and apply of the unchanged configuration.
+func TestAccResourceInteger_StableAcrossPlanAndApply(t *testing.T) {
+	t.Parallel()
+
+	var result string
+	config := `resource "random_id" "id_1" {
+				byte_length = 4
+			}
+
+			resource "random_integer" "integer_1" {
+				min = 1
+				max = 1000000
+				keepers = {
+					id = random_id.id_1.hex
+				}
+			}`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCheckAttrCapture("random_integer.integer_1", "result", &result),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				Config: config,
+				Check:  testAccCheckAttrEquals("random_integer.integer_1", "result", &result),
+			},
+		},
+	})
+}
+
 func TestAccResourceInteger_Big(t *testing.T) {
 	t.Parallel()
 	resource.UnitTest(t, resource.TestCase{
@@ -186,3 +2057,120 @@ func testCheckNotEmptyString(field string) func(input string) error {
 		return nil
 	}
 }
+
+// testAccResourceIntegerCheckNormalized checks that normalized is the position of result between min and max.
+func testAccResourceIntegerCheckNormalized(name string, min, max int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		result, err := strconv.ParseInt(rs.Primary.Attributes["result"], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		normalized, err := strconv.ParseFloat(rs.Primary.Attributes["normalized"], 64)
+		if err != nil {
+			return err
+		}
+
+		if expected := float64(result-min) / float64(max-min); normalized != expected {
+			return fmt.Errorf("expected normalized %v for result %d, got %v", expected, result, normalized)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceIntegerCheckMinDistance(name string, distance int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["results.#"])
+		if err != nil {
+			return err
+		}
+
+		results := make([]int64, 0, count)
+		for i := 0; i < count; i++ {
+			v, err := strconv.ParseInt(rs.Primary.Attributes[fmt.Sprintf("results.%d", i)], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			for _, prev := range results {
+				if d := v - prev; d < distance && d > -distance {
+					return fmt.Errorf("expected results to be at least %d apart, got %d and %d", distance, prev, v)
+				}
+			}
+
+			results = append(results, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceIntegerCheckRanges(name string, ranges []integerRange) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["results.#"])
+		if err != nil {
+			return err
+		}
+
+	Results:
+		for i := 0; i < count; i++ {
+			v, err := strconv.Atoi(rs.Primary.Attributes[fmt.Sprintf("results.%d", i)])
+			if err != nil {
+				return err
+			}
+
+			for _, r := range ranges {
+				if v >= r.min && v <= r.max {
+					continue Results
+				}
+			}
+
+			return fmt.Errorf("expected results within %v, got %d", ranges, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceIntegerCheckModulus(name string, modulus, residue int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["results.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			v, err := strconv.ParseInt(rs.Primary.Attributes[fmt.Sprintf("results.%d", i)], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if rem := (v%modulus + modulus) % modulus; rem != residue {
+				return fmt.Errorf("expected results to leave a remainder of %d when divided by %d, got %d", residue, modulus, v)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+)
+
+// lineDefaultLines are the lorem ipsum sentences that lines are drawn from when no lines are given.
+var lineDefaultLines = []string{
+	"Lorem ipsum dolor sit amet, consectetur adipiscing elit.",
+	"Sed do eiusmod tempor incididunt ut labore et dolore magna aliqua.",
+	"Ut enim ad minim veniam, quis nostrud exercitation ullamco laboris.",
+	"Nisi ut aliquip ex ea commodo consequat.",
+	"Duis aute irure dolor in reprehenderit in voluptate velit esse.",
+	"Cillum dolore eu fugiat nulla pariatur.",
+	"Excepteur sint occaecat cupidatat non proident.",
+	"Sunt in culpa qui officia deserunt mollit anim id est laborum.",
+}
+
+// lineMaxResultCount is the maximum value of result_count, which bounds the number of lines picked during apply.
+const lineMaxResultCount = 10000
+
+var _ tfsdk.ResourceType = (*lineResourceType)(nil)
+
+type lineResourceType struct{}
+
+func (r *lineResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	defaultLines := make([]attr.Value, 0, len(lineDefaultLines))
+	for _, l := range lineDefaultLines {
+		defaultLines = append(defaultLines, types.String{Value: l})
+	}
+
+	return tfsdk.Schema{
+		Description: "The resource `random_line` picks random lines from a list, e.g. for demo and " +
+			"placeholder content. When no lines are given, lorem ipsum sentences are used.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"lines": {
+				Description: "The list of lines to pick from. Elements must not be null. Default value is a " +
+					fmt.Sprintf("list of %d lorem ipsum sentences.", len(lineDefaultLines)),
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.List{
+						ElemType: types.StringType,
+						Elems:    defaultLines,
+					}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"result_count": {
+				Description: "The number of lines to pick into `results`. When set, `results` holds `result` " +
+					"followed by `result_count - 1` further lines. " +
+					fmt.Sprintf("Must be between `1` and `%d`.", lineMaxResultCount),
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, lineMaxResultCount),
+				},
+			},
+			"unique": {
+				Description: "Guarantee that all lines in `results` are distinct. The number of distinct " +
+					"lines in `lines` must be at least `result_count`. Requires `result_count`. Default value " +
+					"is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.AlsoRequires(path.MatchRoot("result_count")),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile results.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same result " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The picked line.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"results": {
+				Description: "The `result_count` picked lines, starting with `result`. Only set when " +
+					"`result_count` is set.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *lineResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &lineResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*lineResource)(nil)
+
+type lineResource struct {
+	source *randSource
+}
+
+func (r *lineResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan lineModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lines := make([]string, 0, len(plan.Lines.Elems))
+	for i, v := range plan.Lines.Elems {
+		if v.IsNull() {
+			resp.Diagnostics.AddError(
+				"Create Random Line Error",
+				fmt.Sprintf("The lines list needs to contain strings only, got null at index %d.", i),
+			)
+			return
+		}
+
+		lines = append(lines, v.(types.String).Value)
+	}
+
+	count := 1
+	if !plan.ResultCount.Null {
+		count = int(plan.ResultCount.Value)
+	}
+
+	if plan.Unique.Value {
+		lines = lineDistinct(lines)
+
+		if count > len(lines) {
+			resp.Diagnostics.AddError(
+				"Create Random Line Error",
+				fmt.Sprintf("There are fewer distinct lines (%d) than result_count (%d), so the results "+
+					"cannot be unique.", len(lines), count),
+			)
+			return
+		}
+	}
+
+	picked := linePick(r.source.newRand(plan.Seed.Value, plan.Salt.Value), lines, count, plan.Unique.Value)
+
+	l := lineModelV0{
+		ID:          staticID(),
+		Keepers:     plan.Keepers,
+		Lines:       plan.Lines,
+		ResultCount: plan.ResultCount,
+		Unique:      plan.Unique,
+		Seed:        plan.Seed,
+		Salt:        plan.Salt,
+		Result:      types.String{Value: picked[0]},
+		Results:     types.List{ElemType: types.StringType, Null: true},
+	}
+
+	if !plan.ResultCount.Null {
+		l.Results.Null = false
+		for _, line := range picked {
+			l.Results.Elems = append(l.Results.Elems, types.String{Value: line})
+		}
+	}
+
+	diags = resp.State.Set(ctx, l)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *lineResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *lineResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *lineResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// linePick returns count lines drawn from rand. When unique is true, every line is drawn at most once, so lines must
+// hold at least count elements.
+func linePick(rand *rand.Rand, lines []string, count int, unique bool) []string {
+	picked := make([]string, 0, count)
+
+	if unique {
+		for _, i := range rand.Perm(len(lines))[:count] {
+			picked = append(picked, lines[i])
+		}
+
+		return picked
+	}
+
+	for i := 0; i < count; i++ {
+		picked = append(picked, lines[rand.Intn(len(lines))])
+	}
+
+	return picked
+}
+
+// lineDistinct returns lines with duplicates removed, keeping the first occurrence of every line.
+func lineDistinct(lines []string) []string {
+	distinct := make([]string, 0, len(lines))
+	seen := make(map[string]struct{}, len(lines))
+
+	for _, line := range lines {
+		if _, ok := seen[line]; ok {
+			continue
+		}
+
+		seen[line] = struct{}{}
+		distinct = append(distinct, line)
+	}
+
+	return distinct
+}
+
+type lineModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Keepers     types.Map    `tfsdk:"keepers"`
+	Lines       types.List   `tfsdk:"lines"`
+	ResultCount types.Int64  `tfsdk:"result_count"`
+	Unique      types.Bool   `tfsdk:"unique"`
+	Seed        types.String `tfsdk:"seed"`
+	Salt        types.String `tfsdk:"salt"`
+	Result      types.String `tfsdk:"result"`
+	Results     types.List   `tfsdk:"results"`
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceLine(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_line" "default" {
+						}
+						resource "random_line" "lines" {
+							lines = ["alpha", "beta"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_line.default", "lines.#", "8"),
+					resource.TestCheckResourceAttr("random_line.default", "lines.0", "Lorem ipsum dolor sit amet, consectetur adipiscing elit."),
+					resource.TestMatchResourceAttr("random_line.default", "result", regexp.MustCompile(`^[A-Z][a-z ,]+\.$`)),
+					resource.TestCheckNoResourceAttr("random_line.default", "results"),
+					resource.TestMatchResourceAttr("random_line.lines", "result", regexp.MustCompile(`^(alpha|beta)$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceLine_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_line" "seeded" {
+							result_count = 3
+							seed         = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_line.seeded", "result", "Nisi ut aliquip ex ea commodo consequat."),
+					resource.TestCheckResourceAttrPair("random_line.seeded", "result", "random_line.seeded", "results.0"),
+					resource.TestCheckResourceAttr("random_line.seeded", "results.#", "3"),
+					resource.TestCheckResourceAttr("random_line.seeded", "results.2", "Lorem ipsum dolor sit amet, consectetur adipiscing elit."),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceLine_Unique(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_line" "unique" {
+							lines        = ["a", "b", "c", "a", "b", "c"]
+							result_count = 3
+							unique       = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_line.unique", "results.#", "3"),
+					testAccResourceStringCheckUnique("random_line.unique"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceLine_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_line" "line" {
+							lines = []
+						}`,
+				ExpectError: regexp.MustCompile(`List must contain at least 1 elements, got: 0`),
+			},
+			{
+				Config: `resource "random_line" "line" {
+							lines        = ["a", "b", "a"]
+							result_count = 3
+							unique       = true
+						}`,
+				ExpectError: regexp.MustCompile(`There are fewer distinct lines \(2\) than result_count \(3\)`),
+			},
+			{
+				Config: `resource "random_line" "line" {
+							unique = true
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "result_count" must be specified when "unique" is specified`),
+			},
+			{
+				Config: `resource "random_line" "line" {
+							result_count = 10001
+						}`,
+				ExpectError: regexp.MustCompile(`Value must be between 1 and 10000, got: 10001`),
+			},
+		},
+	})
+}
@@ -2,8 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -28,13 +32,103 @@ func (r *passwordResourceType) NewResource(_ context.Context, _ tfsdk.Provider)
 }
 
 var (
-	_ tfsdk.Resource                 = (*passwordResource)(nil)
-	_ tfsdk.ResourceWithImportState  = (*passwordResource)(nil)
-	_ tfsdk.ResourceWithUpgradeState = (*passwordResource)(nil)
+	_ tfsdk.Resource                   = (*passwordResource)(nil)
+	_ tfsdk.ResourceWithImportState    = (*passwordResource)(nil)
+	_ tfsdk.ResourceWithUpgradeState   = (*passwordResource)(nil)
+	_ tfsdk.ResourceWithValidateConfig = (*passwordResource)(nil)
 )
 
 type passwordResource struct{}
 
+// ValidateConfig checks that length is set unless hybrid is true, and that the arguments of hybrid passphrases are
+// set if and only if hybrid is true, as this cannot be expressed with attribute validators on a boolean that may be
+// set to false.
+func (r *passwordResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config passwordModelV2
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Hybrid.Unknown {
+		return
+	}
+
+	if !config.Hybrid.Value {
+		if config.Length.Null {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("length"),
+				"Missing Attribute Configuration",
+				"The length argument needs to be set unless hybrid is true.",
+			)
+		}
+
+		for _, a := range []struct {
+			name  string
+			value attr.Value
+		}{
+			{"word_count", config.WordCount},
+			{"word_separator", config.WordSeparator},
+		} {
+			if !a.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(a.name),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("The %s argument can only be set when hybrid is true.", a.name),
+				)
+			}
+		}
+
+		return
+	}
+
+	if config.WordCount.Null {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("word_count"),
+			"Missing Attribute Configuration",
+			"The word_count argument needs to be set when hybrid is true.",
+		)
+	}
+
+	if !config.Special.Null && !config.Special.Unknown && !config.Special.Value {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("special"),
+			"Invalid Attribute Combination",
+			"The special argument cannot be false when hybrid is true, as the passphrase ends with a symbol.",
+		)
+	}
+
+	// These arguments have no effect on a hybrid passphrase.
+	for _, a := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"length", config.Length},
+		{"upper", config.Upper},
+		{"lower", config.Lower},
+		{"numeric", config.Numeric},
+		{"min_numeric", config.MinNumeric},
+		{"min_upper", config.MinUpper},
+		{"min_lower", config.MinLower},
+		{"min_special", config.MinSpecial},
+		{"exclude_sequential", config.ExcludeSequential},
+		{"exclude_repeated", config.ExcludeRepeated},
+		{"exclude_keyboard_sequences", config.ExcludeKeyboardSequences},
+		{"max_sequence", config.MaxSequence},
+		{"min_entropy_bits", config.MinEntropyBits},
+	} {
+		if !a.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(a.name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The %s argument cannot be set when hybrid is true.", a.name),
+			)
+		}
+	}
+}
+
 func (r *passwordResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	var plan passwordModelV2
 
@@ -44,41 +138,121 @@ func (r *passwordResource) Create(ctx context.Context, req tfsdk.CreateResourceR
 		return
 	}
 
+	if plan.Hybrid.Value {
+		r.createHybrid(ctx, plan, resp)
+		return
+	}
+
+	maxSequence := plan.MaxSequence.Value
+	if plan.MaxSequence.Null {
+		maxSequence = 2
+	}
+
 	params := random.StringParams{
-		Length:          plan.Length.Value,
-		Upper:           plan.Upper.Value,
-		MinUpper:        plan.MinUpper.Value,
-		Lower:           plan.Lower.Value,
-		MinLower:        plan.MinLower.Value,
-		Numeric:         plan.Numeric.Value,
-		MinNumeric:      plan.MinNumeric.Value,
-		Special:         plan.Special.Value,
-		MinSpecial:      plan.MinSpecial.Value,
-		OverrideSpecial: plan.OverrideSpecial.Value,
+		Length:            plan.Length.Value,
+		Upper:             plan.Upper.Value,
+		MinUpper:          plan.MinUpper.Value,
+		Lower:             plan.Lower.Value,
+		MinLower:          plan.MinLower.Value,
+		Numeric:           plan.Numeric.Value,
+		MinNumeric:        plan.MinNumeric.Value,
+		Special:           plan.Special.Value,
+		MinSpecial:        plan.MinSpecial.Value,
+		OverrideSpecial:   plan.OverrideSpecial.Value,
+		ExcludeSequential: plan.ExcludeSequential.Value,
+		ExcludeRepeated:   plan.ExcludeRepeated.Value,
+		ExcludeKeyboard:   plan.ExcludeKeyboardSequences.Value,
+		MaxSequence:       maxSequence,
+		MinEntropy:        float64(plan.MinEntropyBits.Value),
+	}
+
+	if params.ExcludeRepeated && params.Length > maxSequence && len(params.Chars()) < 2 {
+		resp.Diagnostics.AddError(
+			"Create Random Password Error",
+			"At least two different characters need to be enabled when exclude_repeated is true and the length "+
+				"is greater than max_sequence.",
+		)
+		return
+	}
+
+	if params.ExcludeKeyboard && !params.RunsAvoidable() {
+		resp.Diagnostics.AddError(
+			"Create Random Password Error",
+			"Every pair of enabled characters forms a keyboard sequence, or a sequential or repeated run "+
+				"that is excluded, so no result can be longer than max_sequence when exclude_keyboard_sequences "+
+				"is true. Enable more characters, increase max_sequence or reduce length.",
+		)
+		return
+	}
+
+	if max := random.MaxEntropyEstimate(params); params.MinEntropy > max {
+		resp.Diagnostics.AddError(
+			"Create Random Password Error",
+			fmt.Sprintf("The estimated entropy of a result is at most %.1f bits for the given length and ", max)+
+				fmt.Sprintf("character classes, below min_entropy_bits (%d). Increase length or enable more ", plan.MinEntropyBits.Value)+
+				"character classes.",
+		)
+		return
 	}
 
 	result, err := random.CreateString(params)
+	if errors.Is(err, random.ErrMaxAttempts) && !plan.MinEntropyBits.Null {
+		resp.Diagnostics.AddError(
+			"Create Random Password Error",
+			fmt.Sprintf("Unable to generate a result with an estimated entropy of at least %d bits (min_entropy_bits) ", plan.MinEntropyBits.Value)+
+				fmt.Sprintf("and without excluded sequential, repeated or keyboard sequence characters within %d attempts. ", random.MaxAttempts)+
+				"Increase length, enable more character classes or reduce min_entropy_bits.",
+		)
+		return
+	}
+	if errors.Is(err, random.ErrMaxAttempts) {
+		resp.Diagnostics.AddError(
+			"Create Random Password Error",
+			fmt.Sprintf("Unable to generate a result without sequential, repeated or keyboard sequence characters within %d attempts. ", random.MaxAttempts)+
+				"Enable more character classes, increase max_sequence or reduce length.",
+		)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+		resp.Diagnostics.Append(diagnostics.GenerationError("Create Random Password Error", err)...)
 		return
 	}
 
+	// The result is sensitive and must never appear in diagnostics, whichever error path produces them.
+	defer func() {
+		resp.Diagnostics = diagnostics.Redact(resp.Diagnostics, string(result))
+	}()
+
 	state := passwordModelV2{
-		ID:              types.String{Value: "none"},
-		Keepers:         plan.Keepers,
-		Length:          types.Int64{Value: plan.Length.Value},
-		Special:         types.Bool{Value: plan.Special.Value},
-		Upper:           types.Bool{Value: plan.Upper.Value},
-		Lower:           types.Bool{Value: plan.Lower.Value},
-		Numeric:         types.Bool{Value: plan.Numeric.Value},
-		MinNumeric:      types.Int64{Value: plan.MinNumeric.Value},
-		MinUpper:        types.Int64{Value: plan.MinUpper.Value},
-		MinLower:        types.Int64{Value: plan.MinLower.Value},
-		MinSpecial:      types.Int64{Value: plan.MinSpecial.Value},
-		OverrideSpecial: types.String{Value: plan.OverrideSpecial.Value},
-		Result:          types.String{Value: string(result)},
+		ID:                       sensitiveID(),
+		Keepers:                  plan.Keepers,
+		RegenerateOn:             plan.RegenerateOn,
+		Nonce:                    plan.Nonce,
+		Length:                   types.Int64{Value: plan.Length.Value},
+		Special:                  types.Bool{Value: plan.Special.Value},
+		Upper:                    types.Bool{Value: plan.Upper.Value},
+		Lower:                    types.Bool{Value: plan.Lower.Value},
+		Numeric:                  types.Bool{Value: plan.Numeric.Value},
+		MinNumeric:               types.Int64{Value: plan.MinNumeric.Value},
+		MinUpper:                 types.Int64{Value: plan.MinUpper.Value},
+		MinLower:                 types.Int64{Value: plan.MinLower.Value},
+		MinSpecial:               types.Int64{Value: plan.MinSpecial.Value},
+		OverrideSpecial:          types.String{Value: plan.OverrideSpecial.Value},
+		ExcludeSequential:        plan.ExcludeSequential,
+		ExcludeRepeated:          plan.ExcludeRepeated,
+		ExcludeKeyboardSequences: plan.ExcludeKeyboardSequences,
+		MaxSequence:              plan.MaxSequence,
+		MinEntropyBits:           plan.MinEntropyBits,
+		Hybrid:                   plan.Hybrid,
+		WordCount:                plan.WordCount,
+		WordSeparator:            plan.WordSeparator,
+		WordEntropy:              types.Number{Null: true},
+		SuffixEntropy:            types.Number{Null: true},
+		Result:                   types.String{Value: string(result)},
 	}
 
+	state.Strength, state.StrengthLabel = passwordStrength(string(result))
+
 	hash, err := generateHash(plan.Result.Value)
 	if err != nil {
 		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
@@ -93,6 +267,74 @@ func (r *passwordResource) Create(ctx context.Context, req tfsdk.CreateResourceR
 	}
 }
 
+// createHybrid generates a passphrase of capitalized words followed by a digit and a symbol, e.g. Tiger-Maple-7!.
+func (r *passwordResource) createHybrid(ctx context.Context, plan passwordModelV2, resp *tfsdk.CreateResourceResponse) {
+	separator := "-"
+	if !plan.WordSeparator.Null {
+		separator = plan.WordSeparator.Value
+	}
+
+	params := random.HybridPassphraseParams{
+		WordCount: plan.WordCount.Value,
+		Separator: separator,
+		Symbols:   random.StringParams{OverrideSpecial: plan.OverrideSpecial.Value}.SpecialChars(),
+	}
+	words := random.HybridPassphraseWords()
+
+	result, err := random.CreateHybridPassphrase(words, params)
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.GenerationError("Create Random Password Error", err)...)
+		return
+	}
+
+	// The result is sensitive and must never appear in diagnostics, whichever error path produces them.
+	defer func() {
+		resp.Diagnostics = diagnostics.Redact(resp.Diagnostics, string(result))
+	}()
+
+	wordBits, suffixBits := random.HybridPassphraseEntropy(words, params)
+
+	state := passwordModelV2{
+		ID:                       sensitiveID(),
+		Keepers:                  plan.Keepers,
+		RegenerateOn:             plan.RegenerateOn,
+		Nonce:                    plan.Nonce,
+		Length:                   plan.Length,
+		Special:                  types.Bool{Value: plan.Special.Value},
+		Upper:                    types.Bool{Value: plan.Upper.Value},
+		Lower:                    types.Bool{Value: plan.Lower.Value},
+		Numeric:                  types.Bool{Value: plan.Numeric.Value},
+		MinNumeric:               types.Int64{Value: plan.MinNumeric.Value},
+		MinUpper:                 types.Int64{Value: plan.MinUpper.Value},
+		MinLower:                 types.Int64{Value: plan.MinLower.Value},
+		MinSpecial:               types.Int64{Value: plan.MinSpecial.Value},
+		OverrideSpecial:          types.String{Value: plan.OverrideSpecial.Value},
+		ExcludeSequential:        plan.ExcludeSequential,
+		ExcludeRepeated:          plan.ExcludeRepeated,
+		ExcludeKeyboardSequences: plan.ExcludeKeyboardSequences,
+		MaxSequence:              plan.MaxSequence,
+		MinEntropyBits:           plan.MinEntropyBits,
+		Hybrid:                   plan.Hybrid,
+		WordCount:                plan.WordCount,
+		WordSeparator:            plan.WordSeparator,
+		WordEntropy:              types.Number{Value: big.NewFloat(wordBits)},
+		SuffixEntropy:            types.Number{Value: big.NewFloat(suffixBits)},
+		Result:                   types.String{Value: string(result)},
+	}
+
+	state.Strength, state.StrengthLabel = passwordStrength(string(result))
+
+	hash, err := generateHash(string(result))
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+	}
+
+	state.BcryptHash = types.String{Value: hash}
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
 // Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
 func (r *passwordResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
 }
@@ -110,14 +352,22 @@ func (r *passwordResource) Delete(ctx context.Context, req tfsdk.DeleteResourceR
 func (r *passwordResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
 	id := req.ID
 
+	defer func() {
+		resp.Diagnostics = diagnostics.Redact(resp.Diagnostics, id)
+	}()
+
+	// The character classes are inferred from the imported value, so that a configuration matching the
+	// value does not trigger a replacement.
+	inferred := random.InferStringParams(id)
+
 	state := passwordModelV2{
-		ID:         types.String{Value: "none"},
+		ID:         sensitiveID(),
 		Result:     types.String{Value: id},
-		Length:     types.Int64{Value: int64(len(id))},
-		Special:    types.Bool{Value: true},
-		Upper:      types.Bool{Value: true},
-		Lower:      types.Bool{Value: true},
-		Numeric:    types.Bool{Value: true},
+		Length:     types.Int64{Value: inferred.Length},
+		Special:    types.Bool{Value: inferred.Special},
+		Upper:      types.Bool{Value: inferred.Upper},
+		Lower:      types.Bool{Value: inferred.Lower},
+		Numeric:    types.Bool{Value: inferred.Numeric},
 		MinSpecial: types.Int64{Value: 0},
 		MinUpper:   types.Int64{Value: 0},
 		MinLower:   types.Int64{Value: 0},
@@ -125,6 +375,19 @@ func (r *passwordResource) ImportState(ctx context.Context, req tfsdk.ImportReso
 	}
 
 	state.Keepers.ElemType = types.StringType
+	state.RegenerateOn = types.Set{ElemType: types.StringType, Null: true}
+	state.Nonce.Null = true
+	state.ExcludeSequential.Null = true
+	state.ExcludeRepeated.Null = true
+	state.ExcludeKeyboardSequences.Null = true
+	state.MaxSequence.Null = true
+	state.MinEntropyBits.Null = true
+	state.Hybrid.Null = true
+	state.WordCount.Null = true
+	state.WordSeparator.Null = true
+	state.WordEntropy.Null = true
+	state.SuffixEntropy.Null = true
+	state.Strength, state.StrengthLabel = passwordStrength(id)
 
 	hash, err := generateHash(id)
 	if err != nil {
@@ -180,6 +443,10 @@ func upgradePasswordStateV0toV2(ctx context.Context, req tfsdk.UpgradeResourceSt
 		return
 	}
 
+	defer func() {
+		resp.Diagnostics = diagnostics.Redact(resp.Diagnostics, passwordDataV0.Result.Value)
+	}()
+
 	passwordDataV2 := passwordModelV2{
 		Keepers:         passwordDataV0.Keepers,
 		Length:          passwordDataV0.Length,
@@ -195,6 +462,20 @@ func upgradePasswordStateV0toV2(ctx context.Context, req tfsdk.UpgradeResourceSt
 		ID:              passwordDataV0.ID,
 	}
 
+	passwordDataV2.RegenerateOn = types.Set{ElemType: types.StringType, Null: true}
+	passwordDataV2.Nonce.Null = true
+	passwordDataV2.ExcludeSequential.Null = true
+	passwordDataV2.ExcludeRepeated.Null = true
+	passwordDataV2.ExcludeKeyboardSequences.Null = true
+	passwordDataV2.MaxSequence.Null = true
+	passwordDataV2.MinEntropyBits.Null = true
+	passwordDataV2.Hybrid.Null = true
+	passwordDataV2.WordCount.Null = true
+	passwordDataV2.WordSeparator.Null = true
+	passwordDataV2.WordEntropy.Null = true
+	passwordDataV2.SuffixEntropy.Null = true
+	passwordDataV2.Strength, passwordDataV2.StrengthLabel = passwordStrength(passwordDataV2.Result.Value)
+
 	hash, err := generateHash(passwordDataV2.Result.Value)
 	if err != nil {
 		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
@@ -232,6 +513,10 @@ func upgradePasswordStateV1toV2(ctx context.Context, req tfsdk.UpgradeResourceSt
 		return
 	}
 
+	defer func() {
+		resp.Diagnostics = diagnostics.Redact(resp.Diagnostics, passwordDataV1.Result.Value)
+	}()
+
 	passwordDataV2 := passwordModelV2{
 		Keepers:         passwordDataV1.Keepers,
 		Length:          passwordDataV1.Length,
@@ -248,10 +533,31 @@ func upgradePasswordStateV1toV2(ctx context.Context, req tfsdk.UpgradeResourceSt
 		ID:              passwordDataV1.ID,
 	}
 
+	passwordDataV2.RegenerateOn = types.Set{ElemType: types.StringType, Null: true}
+	passwordDataV2.Nonce.Null = true
+	passwordDataV2.ExcludeSequential.Null = true
+	passwordDataV2.ExcludeRepeated.Null = true
+	passwordDataV2.ExcludeKeyboardSequences.Null = true
+	passwordDataV2.MaxSequence.Null = true
+	passwordDataV2.MinEntropyBits.Null = true
+	passwordDataV2.Hybrid.Null = true
+	passwordDataV2.WordCount.Null = true
+	passwordDataV2.WordSeparator.Null = true
+	passwordDataV2.WordEntropy.Null = true
+	passwordDataV2.SuffixEntropy.Null = true
+	passwordDataV2.Strength, passwordDataV2.StrengthLabel = passwordStrength(passwordDataV2.Result.Value)
+
 	diags := resp.State.Set(ctx, passwordDataV2)
 	resp.Diagnostics.Append(diags...)
 }
 
+// passwordStrength returns the heuristic strength score of result and the label describing it.
+func passwordStrength(result string) (types.Int64, types.String) {
+	score := random.Strength([]byte(result))
+
+	return types.Int64{Value: score}, types.String{Value: random.StrengthLabels[score]}
+}
+
 func generateHash(toHash string) (string, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(toHash), bcrypt.DefaultCost)
 
@@ -279,11 +585,15 @@ func passwordSchemaV2() tfsdk.Schema {
 				},
 			},
 
+			"regenerate_on": regenerateOnAttribute(),
+			"nonce":         nonceAttribute(),
+
 			"length": {
 				Description: "The length of the string desired. The minimum value for length is 1 and, length " +
-					"must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special`).",
+					"must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special`). Required " +
+					"unless `hybrid` is `true`, in which case it cannot be set.",
 				Type:     types.Int64Type,
-				Required: true,
+				Optional: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					tfsdk.RequiresReplace(),
 				},
@@ -398,6 +708,127 @@ func passwordSchemaV2() tfsdk.Schema {
 				},
 			},
 
+			"exclude_sequential": {
+				Description: "Reject results containing more than `max_sequence` consecutive sequential " +
+					"characters, ascending or descending, within the digits or the lowercase or uppercase " +
+					"alphabet, e.g. `123` or `cba`. Rejected results are re-drawn. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"exclude_repeated": {
+				Description: "Reject results containing more than `max_sequence` consecutive occurrences of " +
+					"the same character, e.g. `aaa`. Rejected results are re-drawn. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"exclude_keyboard_sequences": {
+				Description: "Reject results containing more than `max_sequence` consecutive characters typed " +
+					"with adjacent keys of the same row of a US QWERTY keyboard, left to right or right to " +
+					"left and ignoring shift, e.g. `qwe`, `lkj` or `#@!`. Rejected results are re-drawn. " +
+					"Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"max_sequence": {
+				Description: "The maximum number of consecutive sequential, repeated or keyboard-adjacent " +
+					"characters allowed when `exclude_sequential`, `exclude_repeated` or " +
+					"`exclude_keyboard_sequences` is enabled. The minimum value is 1. " +
+					"Default value is `2`.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"min_entropy_bits": {
+				Description: "Re-draw the result while its estimated entropy, as used for `strength`, is below " +
+					"this number of bits, giving up " + fmt.Sprintf("after %d attempts. ", random.MaxAttempts) +
+					"The estimate counts only the character classes that the result actually contains, so a " +
+					"high target also requires the result to mix classes. An error is raised when the target " +
+					"exceeds the estimate for a result of `length` characters containing every enabled class. " +
+					"The minimum value is 1.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"hybrid": {
+				Description: "Generate a passphrase of `word_count` capitalized words followed by a digit " +
+					"and a symbol, joined by `word_separator`, e.g. `Tiger-Maple-7!`, instead of a string of " +
+					"`length` characters. Words are drawn from the adjectives and names used by " +
+					"[random_pet](pet.html), and the symbol from the special characters, which can be " +
+					"replaced with `override_special`. `length`, `upper`, `lower`, `numeric`, the `min_*` " +
+					"arguments, `exclude_sequential`, `exclude_repeated`, `exclude_keyboard_sequences`, " +
+					"`max_sequence` and " +
+					"`min_entropy_bits` cannot be set, " +
+					"and `special` cannot be `false`. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"word_count": {
+				Description: "The number of words in the passphrase. The minimum value is 1. Required when " +
+					"`hybrid` is `true`, and cannot be set otherwise.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"word_separator": {
+				Description: "The separator between the words and the digit and symbol of the passphrase. " +
+					"Can only be set when `hybrid` is `true`. Default value is `-`.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"word_entropy": {
+				Description: "The entropy, in bits, contributed by the words of the passphrase, i.e. " +
+					"`word_count` times the base 2 logarithm of the number of words they are drawn from. Only " +
+					"set when `hybrid` is `true`.",
+				Type:     types.NumberType,
+				Computed: true,
+			},
+
+			"suffix_entropy": {
+				Description: "The entropy, in bits, contributed by the digit and symbol ending the passphrase, " +
+					"i.e. the base 2 logarithm of 10 times the number of distinct symbols. Only set when " +
+					"`hybrid` is `true`.",
+				Type:     types.NumberType,
+				Computed: true,
+			},
+
 			"result": {
 				Description: "The generated random string.",
 				Type:        types.StringType,
@@ -405,6 +836,24 @@ func passwordSchemaV2() tfsdk.Schema {
 				Sensitive:   true,
 			},
 
+			"strength": {
+				Description: "A heuristic estimate of the strength of `result`, from `0` (very weak) to `4` " +
+					"(very strong), in the spirit of [zxcvbn](https://github.com/dropbox/zxcvbn). The score is " +
+					"derived from the entropy of `result`, assuming each character was drawn from the union of " +
+					"the character classes it contains: lowercase letters (26), uppercase letters (26), digits " +
+					"(10) and other characters (33). Characters extending a run of three or more repeated or " +
+					"sequential characters, e.g. `aaa` or `abc`, do not count towards the estimate. Scores of " +
+					"`1`, `2`, `3` and `4` require at least 28, 36, 60 and 128 bits respectively.",
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+
+			"strength_label": {
+				Description: "The label for `strength`: one of `very weak`, `weak`, `fair`, `strong` or `very strong`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+
 			"bcrypt_hash": {
 				Description: "A bcrypt hash of the generated random string.",
 				Type:        types.StringType,
@@ -734,18 +1183,32 @@ func passwordSchemaV0() tfsdk.Schema {
 }
 
 type passwordModelV2 struct {
-	ID              types.String `tfsdk:"id"`
-	Keepers         types.Map    `tfsdk:"keepers"`
-	Length          types.Int64  `tfsdk:"length"`
-	Special         types.Bool   `tfsdk:"special"`
-	Upper           types.Bool   `tfsdk:"upper"`
-	Lower           types.Bool   `tfsdk:"lower"`
-	Numeric         types.Bool   `tfsdk:"numeric"`
-	MinNumeric      types.Int64  `tfsdk:"min_numeric"`
-	MinUpper        types.Int64  `tfsdk:"min_upper"`
-	MinLower        types.Int64  `tfsdk:"min_lower"`
-	MinSpecial      types.Int64  `tfsdk:"min_special"`
-	OverrideSpecial types.String `tfsdk:"override_special"`
-	Result          types.String `tfsdk:"result"`
-	BcryptHash      types.String `tfsdk:"bcrypt_hash"`
+	ID                       types.String `tfsdk:"id"`
+	Keepers                  types.Map    `tfsdk:"keepers"`
+	RegenerateOn             types.Set    `tfsdk:"regenerate_on"`
+	Nonce                    types.Int64  `tfsdk:"nonce"`
+	Length                   types.Int64  `tfsdk:"length"`
+	Special                  types.Bool   `tfsdk:"special"`
+	Upper                    types.Bool   `tfsdk:"upper"`
+	Lower                    types.Bool   `tfsdk:"lower"`
+	Numeric                  types.Bool   `tfsdk:"numeric"`
+	MinNumeric               types.Int64  `tfsdk:"min_numeric"`
+	MinUpper                 types.Int64  `tfsdk:"min_upper"`
+	MinLower                 types.Int64  `tfsdk:"min_lower"`
+	MinSpecial               types.Int64  `tfsdk:"min_special"`
+	OverrideSpecial          types.String `tfsdk:"override_special"`
+	ExcludeSequential        types.Bool   `tfsdk:"exclude_sequential"`
+	ExcludeRepeated          types.Bool   `tfsdk:"exclude_repeated"`
+	ExcludeKeyboardSequences types.Bool   `tfsdk:"exclude_keyboard_sequences"`
+	MaxSequence              types.Int64  `tfsdk:"max_sequence"`
+	MinEntropyBits           types.Int64  `tfsdk:"min_entropy_bits"`
+	Hybrid                   types.Bool   `tfsdk:"hybrid"`
+	WordCount                types.Int64  `tfsdk:"word_count"`
+	WordSeparator            types.String `tfsdk:"word_separator"`
+	WordEntropy              types.Number `tfsdk:"word_entropy"`
+	SuffixEntropy            types.Number `tfsdk:"suffix_entropy"`
+	Result                   types.String `tfsdk:"result"`
+	Strength                 types.Int64  `tfsdk:"strength"`
+	StrengthLabel            types.String `tfsdk:"strength_label"`
+	BcryptHash               types.String `tfsdk:"bcrypt_hash"`
 }
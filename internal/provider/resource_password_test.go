@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -44,9 +46,48 @@ func TestAccResourcePassword(t *testing.T) {
 
 					return rs.Primary.Attributes["result"], nil
 				},
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"bcrypt_hash"},
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The character classes are inferred from the imported result, which need not contain a
+				// character of every enabled class.
+				ImportStateVerifyIgnore: []string{"bcrypt_hash", "upper", "lower", "numeric", "special"},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ImportInfersClasses(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "imported" {
+							length = 7
+						}`,
+			},
+			{
+				ResourceName:  "random_password.imported",
+				ImportState:   true,
+				ImportStateId: "hunter2",
+				ImportStateCheck: testAccCheckImportedClasses(map[string]string{
+					"length":  "7",
+					"upper":   "false",
+					"lower":   "true",
+					"numeric": "true",
+					"special": "false",
+				}),
+			},
+			{
+				ResourceName:  "random_password.imported",
+				ImportState:   true,
+				ImportStateId: "DZy_3*tnonj%Q%Yx",
+				ImportStateCheck: testAccCheckImportedClasses(map[string]string{
+					"length":  "16",
+					"upper":   "true",
+					"lower":   "true",
+					"numeric": "true",
+					"special": "true",
+				}),
 			},
 		},
 	})
@@ -525,6 +566,300 @@ func TestAccResourcePassword_Min(t *testing.T) {
 	})
 }
 
+func TestAccResourcePassword_ExcludeSequentialAndRepeated(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "password" {
+							count              = 5
+							length             = 32
+							upper              = false
+							lower              = false
+							special            = false
+							exclude_sequential = true
+							exclude_repeated   = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.password.0", "result", testCheckNoRuns(2)),
+					resource.TestCheckResourceAttrWith("random_password.password.1", "result", testCheckNoRuns(2)),
+					resource.TestCheckResourceAttrWith("random_password.password.2", "result", testCheckNoRuns(2)),
+					resource.TestCheckResourceAttrWith("random_password.password.3", "result", testCheckNoRuns(2)),
+					resource.TestCheckResourceAttrWith("random_password.password.4", "result", testCheckNoRuns(2)),
+				),
+			},
+			{
+				Config: `resource "random_password" "password" {
+							count              = 5
+							length             = 32
+							upper              = false
+							special            = false
+							exclude_sequential = true
+							exclude_repeated   = true
+							max_sequence       = 1
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.password.0", "result", testCheckNoRuns(1)),
+					resource.TestCheckResourceAttrWith("random_password.password.1", "result", testCheckNoRuns(1)),
+					resource.TestCheckResourceAttrWith("random_password.password.2", "result", testCheckNoRuns(1)),
+					resource.TestCheckResourceAttrWith("random_password.password.3", "result", testCheckNoRuns(1)),
+					resource.TestCheckResourceAttrWith("random_password.password.4", "result", testCheckNoRuns(1)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ExcludeSequentialAndRepeatedErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "password" {
+							length           = 8
+							upper            = false
+							lower            = false
+							numeric          = false
+							override_special = "!"
+							exclude_repeated = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*At least two different characters need to be enabled when exclude_repeated is\ntrue and the length is greater than max_sequence.`),
+			},
+			{
+				Config: `resource "random_password" "password" {
+							length             = 8
+							upper              = false
+							lower              = false
+							numeric            = false
+							override_special   = "ab"
+							exclude_sequential = true
+							exclude_repeated   = true
+							max_sequence       = 1
+						}`,
+				ExpectError: regexp.MustCompile(`.*Unable to generate a result without sequential, repeated or keyboard sequence\ncharacters within 1000 attempts.`),
+			},
+			{
+				Config: `resource "random_password" "password" {
+							length           = 8
+							exclude_repeated = true
+							max_sequence     = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ExcludeKeyboardSequences(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "password" {
+							count                      = 5
+							length                     = 64
+							exclude_keyboard_sequences = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_password.password.0", "result", testCheckNoKeyboardRuns),
+					resource.TestCheckResourceAttrWith("random_password.password.1", "result", testCheckNoKeyboardRuns),
+					resource.TestCheckResourceAttrWith("random_password.password.2", "result", testCheckNoKeyboardRuns),
+					resource.TestCheckResourceAttrWith("random_password.password.3", "result", testCheckNoKeyboardRuns),
+					resource.TestCheckResourceAttrWith("random_password.password.4", "result", testCheckNoKeyboardRuns),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ExcludeKeyboardSequencesErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "password" {
+							length                     = 8
+							upper                      = false
+							lower                      = false
+							numeric                    = false
+							override_special           = "!@"
+							exclude_repeated           = true
+							exclude_keyboard_sequences = true
+							max_sequence               = 1
+						}`,
+				ExpectError: regexp.MustCompile(`.*Every pair of enabled characters forms a keyboard sequence`),
+			},
+			{
+				Config: `resource "random_password" "password" {
+							hybrid                     = true
+							word_count                 = 3
+							exclude_keyboard_sequences = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*The exclude_keyboard_sequences argument cannot be set when hybrid is true.`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Strength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "weak" {
+							length = 4
+							upper = false
+							numeric = false
+							special = false
+						}
+						resource "random_password" "strong" {
+							length = 32
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_password.weak", "strength", "0"),
+					resource.TestCheckResourceAttr("random_password.weak", "strength_label", "very weak"),
+					resource.TestCheckResourceAttr("random_password.strong", "strength", "4"),
+					resource.TestCheckResourceAttr("random_password.strong", "strength_label", "very strong"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_MinEntropyBits(t *testing.T) {
+	checks := make([]resource.TestCheckFunc, 0, 10)
+	for i := 0; i < 10; i++ {
+		// A strength of 4 requires an estimated entropy of at least 128 bits.
+		checks = append(checks, resource.TestCheckResourceAttr(fmt.Sprintf("random_password.password.%d", i), "strength", "4"))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// At most 131.4 bits can be estimated for 20 characters, so every result that lacks a character
+				// class or contains a run of three repeated or sequential characters is re-drawn.
+				Config: `resource "random_password" "password" {
+							count            = 10
+							length           = 20
+							min_entropy_bits = 128
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_MinEntropyBitsErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "password" {
+							length           = 16
+							upper            = false
+							numeric          = false
+							special          = false
+							min_entropy_bits = 80
+						}`,
+				ExpectError: regexp.MustCompile(`.*The estimated entropy of a result is at most 75.2 bits for the given length\nand character classes, below min_entropy_bits \(80\).`),
+			},
+			{
+				Config: `resource "random_password" "password" {
+							hybrid           = true
+							word_count       = 3
+							min_entropy_bits = 40
+						}`,
+				ExpectError: regexp.MustCompile(`.*The min_entropy_bits argument cannot be set when hybrid is true.`),
+			},
+			{
+				Config: `resource "random_password" "password" {
+							length           = 16
+							min_entropy_bits = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Hybrid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "hybrid" {
+							hybrid           = true
+							word_count       = 3
+							override_special = "!@#"
+						}
+						resource "random_password" "separator" {
+							hybrid         = true
+							word_count     = 1
+							word_separator = "."
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_password.hybrid", "result", regexp.MustCompile(`^([A-Z][a-z]+-){3}[0-9][!@#]$`)),
+					resource.TestCheckResourceAttr("random_password.hybrid", "word_entropy", "29.465321945911704"),
+					resource.TestCheckResourceAttr("random_password.hybrid", "suffix_entropy", "4.906890595608519"),
+					resource.TestCheckNoResourceAttr("random_password.hybrid", "length"),
+					resource.TestMatchResourceAttr("random_password.separator", "result", regexp.MustCompile(`^[A-Z][a-z]+\.[0-9][!@#$%&*()\-_=+\[\]{}<>:?]$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_HybridErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "hybrid" {
+							hybrid = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*The word_count argument needs to be set when hybrid is true.`),
+			},
+			{
+				Config: `resource "random_password" "hybrid" {
+							hybrid     = true
+							word_count = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+			{
+				Config: `resource "random_password" "hybrid" {
+							hybrid     = true
+							word_count = 3
+							length     = 16
+						}`,
+				ExpectError: regexp.MustCompile(`.*The length argument cannot be set when hybrid is true.`),
+			},
+			{
+				Config: `resource "random_password" "hybrid" {
+							hybrid     = true
+							word_count = 3
+							special    = false
+						}`,
+				ExpectError: regexp.MustCompile(`.*The special argument cannot be false when hybrid is true, as the passphrase\nends with a symbol.`),
+			},
+			{
+				Config: `resource "random_password" "password" {
+							word_count = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*The length argument needs to be set unless hybrid is true.`),
+			},
+			{
+				Config: `resource "random_password" "password" {
+							length     = 16
+							word_count = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*The word_count argument can only be set when hybrid is true.`),
+			},
+		},
+	})
+}
+
 func TestAccResourcePassword_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
@@ -598,6 +933,75 @@ func TestAccResourcePassword_UpgradeFromVersion3_3_2(t *testing.T) {
 	})
 }
 
+func TestPasswordResource_DiagnosticsExcludeResult(t *testing.T) {
+	ctx := context.Background()
+	r := &passwordResource{}
+
+	plan := tfsdk.Plan{
+		Schema: passwordSchemaV2(),
+		Raw:    tftypes.NewValue(passwordSchemaV2().TerraformType(ctx), nil),
+	}
+
+	diags := plan.Set(ctx, passwordModelV2{
+		ID:                       types.String{Unknown: true},
+		Keepers:                  types.Map{ElemType: types.StringType, Null: true},
+		RegenerateOn:             types.Set{ElemType: types.StringType, Null: true},
+		Length:                   types.Int64{Value: 16},
+		Special:                  types.Bool{Value: true},
+		Upper:                    types.Bool{Value: true},
+		Lower:                    types.Bool{Value: true},
+		Numeric:                  types.Bool{Value: true},
+		MinNumeric:               types.Int64{Value: 0},
+		MinUpper:                 types.Int64{Value: 0},
+		MinLower:                 types.Int64{Value: 0},
+		MinSpecial:               types.Int64{Value: 0},
+		OverrideSpecial:          types.String{Null: true},
+		ExcludeSequential:        types.Bool{Null: true},
+		ExcludeRepeated:          types.Bool{Null: true},
+		ExcludeKeyboardSequences: types.Bool{Null: true},
+		MaxSequence:              types.Int64{Null: true},
+		Hybrid:                   types.Bool{Null: true},
+		WordCount:                types.Int64{Null: true},
+		WordSeparator:            types.String{Null: true},
+		WordEntropy:              types.Number{Unknown: true},
+		SuffixEntropy:            types.Number{Unknown: true},
+		Result:                   types.String{Unknown: true},
+		Strength:                 types.Int64{Unknown: true},
+		StrengthLabel:            types.String{Unknown: true},
+		BcryptHash:               types.String{Unknown: true},
+	})
+	if diags.HasError() {
+		t.Fatalf("error setting plan: %v", diags)
+	}
+
+	createResp := &tfsdk.CreateResourceResponse{
+		State: tfsdk.State{
+			Schema: passwordSchemaV2(),
+		},
+	}
+
+	r.Create(ctx, tfsdk.CreateResourceRequest{Plan: plan}, createResp)
+
+	var state passwordModelV2
+	diags = createResp.State.Get(ctx, &state)
+	if diags.HasError() {
+		t.Fatalf("error getting state: %v", diags)
+	}
+
+	testCheckDiagnosticsExclude(t, createResp.Diagnostics, state.Result.Value)
+
+	importResp := &tfsdk.ImportResourceStateResponse{
+		State: tfsdk.State{
+			Schema: passwordSchemaV2(),
+			Raw:    tftypes.NewValue(passwordSchemaV2().TerraformType(ctx), nil),
+		},
+	}
+
+	r.ImportState(ctx, tfsdk.ImportResourceStateRequest{ID: "DZy_3*tnonj%Q%Yx"}, importResp)
+
+	testCheckDiagnosticsExclude(t, importResp.Diagnostics, "DZy_3*tnonj%Q%Yx")
+}
+
 func TestUpgradePasswordStateV0toV2(t *testing.T) {
 	raw := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{
 		"id":               tftypes.NewValue(tftypes.String, "none"),
@@ -631,19 +1035,33 @@ func TestUpgradePasswordStateV0toV2(t *testing.T) {
 	upgradePasswordStateV0toV2(context.Background(), req, resp)
 
 	expected := passwordModelV2{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ID:                       types.String{Value: "none"},
+		Keepers:                  types.Map{Null: true, ElemType: types.StringType},
+		RegenerateOn:             types.Set{Null: true, ElemType: types.StringType},
+		Nonce:                    types.Int64{Null: true},
+		Length:                   types.Int64{Value: 16},
+		Special:                  types.Bool{Value: true},
+		Upper:                    types.Bool{Value: true},
+		Lower:                    types.Bool{Value: true},
+		Numeric:                  types.Bool{Value: true},
+		MinNumeric:               types.Int64{Value: 0},
+		MinUpper:                 types.Int64{Value: 0},
+		MinLower:                 types.Int64{Value: 0},
+		MinSpecial:               types.Int64{Value: 0},
+		OverrideSpecial:          types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
+		ExcludeSequential:        types.Bool{Null: true},
+		ExcludeRepeated:          types.Bool{Null: true},
+		ExcludeKeyboardSequences: types.Bool{Null: true},
+		MaxSequence:              types.Int64{Null: true},
+		MinEntropyBits:           types.Int64{Null: true},
+		Hybrid:                   types.Bool{Null: true},
+		WordCount:                types.Int64{Null: true},
+		WordSeparator:            types.String{Null: true},
+		WordEntropy:              types.Number{Null: true},
+		SuffixEntropy:            types.Number{Null: true},
+		Result:                   types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		Strength:                 types.Int64{Value: 3},
+		StrengthLabel:            types.String{Value: "strong"},
 	}
 
 	actual := passwordModelV2{}
@@ -699,20 +1117,34 @@ func TestUpgradePasswordStateV1toV2(t *testing.T) {
 	upgradePasswordStateV1toV2(context.Background(), req, resp)
 
 	expected := passwordModelV2{
-		ID:              types.String{Value: "none"},
-		Keepers:         types.Map{Null: true, ElemType: types.StringType},
-		Length:          types.Int64{Value: 16},
-		Special:         types.Bool{Value: true},
-		Upper:           types.Bool{Value: true},
-		Lower:           types.Bool{Value: true},
-		Numeric:         types.Bool{Value: true},
-		MinNumeric:      types.Int64{Value: 0},
-		MinUpper:        types.Int64{Value: 0},
-		MinLower:        types.Int64{Value: 0},
-		MinSpecial:      types.Int64{Value: 0},
-		OverrideSpecial: types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
-		BcryptHash:      types.String{Value: "bcrypt_hash"},
-		Result:          types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		ID:                       types.String{Value: "none"},
+		Keepers:                  types.Map{Null: true, ElemType: types.StringType},
+		RegenerateOn:             types.Set{Null: true, ElemType: types.StringType},
+		Nonce:                    types.Int64{Null: true},
+		Length:                   types.Int64{Value: 16},
+		Special:                  types.Bool{Value: true},
+		Upper:                    types.Bool{Value: true},
+		Lower:                    types.Bool{Value: true},
+		Numeric:                  types.Bool{Value: true},
+		MinNumeric:               types.Int64{Value: 0},
+		MinUpper:                 types.Int64{Value: 0},
+		MinLower:                 types.Int64{Value: 0},
+		MinSpecial:               types.Int64{Value: 0},
+		OverrideSpecial:          types.String{Value: "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"},
+		ExcludeSequential:        types.Bool{Null: true},
+		ExcludeRepeated:          types.Bool{Null: true},
+		ExcludeKeyboardSequences: types.Bool{Null: true},
+		MaxSequence:              types.Int64{Null: true},
+		MinEntropyBits:           types.Int64{Null: true},
+		Hybrid:                   types.Bool{Null: true},
+		WordCount:                types.Int64{Null: true},
+		WordSeparator:            types.String{Null: true},
+		WordEntropy:              types.Number{Null: true},
+		SuffixEntropy:            types.Number{Null: true},
+		BcryptHash:               types.String{Value: "bcrypt_hash"},
+		Result:                   types.String{Value: "DZy_3*tnonj%Q%Yx"},
+		Strength:                 types.Int64{Value: 3},
+		StrengthLabel:            types.String{Value: "strong"},
 	}
 
 	actual := passwordModelV2{}
@@ -725,3 +1157,78 @@ func TestUpgradePasswordStateV1toV2(t *testing.T) {
 		t.Errorf("expected: %+v, got: %+v", expected, actual)
 	}
 }
+
+// testCheckNoRuns checks that the input contains no more than maxSequence consecutive repeated characters, or
+// sequential characters within the digits or the lowercase or uppercase alphabet.
+// testCheckNoKeyboardRuns fails when input contains a run of three adjacent keys of a QWERTY keyboard row, ignoring
+// case.
+func testCheckNoKeyboardRuns(input string) error {
+	rows := []string{"`1234567890-=", "~!@#$%^&*()_+", "qwertyuiop[]\\", "{}|", "asdfghjkl;'", "zxcvbnm,./", "<>?"}
+
+	for _, row := range rows {
+		for i := 0; i+3 <= len(row); i++ {
+			run := row[i : i+3]
+			reversed := string([]byte{run[2], run[1], run[0]})
+
+			for _, r := range []string{run, reversed} {
+				if strings.Contains(strings.ToLower(input), r) {
+					return fmt.Errorf("%q contains the keyboard sequence %q", input, r)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCheckNoRuns(maxSequence int) func(input string) error {
+	classes := []string{"0123456789", "abcdefghijklmnopqrstuvwxyz", "ABCDEFGHIJKLMNOPQRSTUVWXYZ"}
+
+	return func(input string) error {
+		for _, step := range []int{0, 1, -1} {
+			run := 1
+
+			for i := 1; i < len(input); i++ {
+				matched := false
+
+				for _, class := range classes {
+					a, b := strings.IndexByte(class, input[i-1]), strings.IndexByte(class, input[i])
+					if a != -1 && b != -1 && b-a == step {
+						matched = true
+					}
+				}
+
+				if step == 0 {
+					matched = input[i-1] == input[i]
+				}
+
+				if matched {
+					run++
+				} else {
+					run = 1
+				}
+
+				if run > maxSequence {
+					return fmt.Errorf("%q contains a run of more than %d characters with step %d", input, maxSequence, step)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// testCheckDiagnosticsExclude fails the test if the summary or detail of any diagnostic contains secret.
+func testCheckDiagnosticsExclude(t *testing.T, diags diag.Diagnostics, secret string) {
+	t.Helper()
+
+	if secret == "" {
+		t.Fatal("expected a non-empty secret")
+	}
+
+	for _, d := range diags {
+		if strings.Contains(d.Summary(), secret) || strings.Contains(d.Detail(), secret) {
+			t.Errorf("diagnostic contains sensitive value: %s: %s", d.Summary(), d.Detail())
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.ResourceType = (*permutationCycleResourceType)(nil)
+
+type permutationCycleResourceType struct{}
+
+func (r *permutationCycleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_permutation_cycle` generates a random derangement of a list of " +
+			"strings given as an argument, i.e. a permutation in which no element stays at its original " +
+			"index. This suits secret-santa style assignments, where `input[i]` is assigned `result[i]`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"input": {
+				Description: "The list of strings to derange. Elements must not be null. The list must not " +
+					"contain exactly one element, as a single element cannot be moved from its index. When " +
+					"`input` contains duplicates, an element may still be equal to the element at its index.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile permutations of the list.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same permutation " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "Random permutation of the list of strings given in `input`, in which no element " +
+					"is at its index in `input`.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *permutationCycleResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &permutationCycleResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*permutationCycleResource)(nil)
+
+type permutationCycleResource struct {
+	source *randSource
+}
+
+func (r *permutationCycleResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan permutationCycleModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := plan.Input.Elems
+
+	for i, v := range input {
+		if v.IsNull() {
+			resp.Diagnostics.AddError(
+				"Create Random Permutation Cycle Error",
+				fmt.Sprintf("The input list needs to contain strings only, got null at index %d.", i),
+			)
+			return
+		}
+	}
+
+	if len(input) == 1 {
+		resp.Diagnostics.AddError(
+			"Create Random Permutation Cycle Error",
+			"The input list contains a single element, which cannot be moved from its index. The input "+
+				"list needs to contain zero, or at least two elements.",
+		)
+		return
+	}
+
+	result := make([]attr.Value, 0, len(input))
+	for _, i := range permutationDerangement(r.source.newRand(plan.Seed.Value, plan.Salt.Value), len(input)) {
+		result = append(result, input[i])
+	}
+
+	p := permutationCycleModelV0{
+		ID:      staticID(),
+		Keepers: plan.Keepers,
+		Input:   plan.Input,
+		Seed:    plan.Seed,
+		Salt:    plan.Salt,
+		Result: types.List{
+			Elems:    result,
+			ElemType: types.StringType,
+		},
+	}
+
+	diags = resp.State.Set(ctx, p)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *permutationCycleResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *permutationCycleResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *permutationCycleResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// permutationDerangement returns a permutation of the indices 0 to n - 1 drawn uniformly from those in which no
+// index is at its own position, using the early refusal algorithm: a Fisher-Yates shuffle that starts over as soon
+// as it fixes an index at its own position. n must not be 1, as no such permutation exists. On average fewer than
+// three shuffles are started.
+func permutationDerangement(rand *rand.Rand, n int) []int {
+	perm := make([]int, n)
+
+shuffles:
+	for {
+		for i := range perm {
+			perm[i] = i
+		}
+
+		// The shuffle fills positions from the last one down, and a filled position is never swapped again.
+		for i := n - 1; i >= 0; i-- {
+			j := rand.Intn(i + 1)
+			perm[i], perm[j] = perm[j], perm[i]
+
+			if perm[i] == i {
+				continue shuffles
+			}
+		}
+
+		return perm
+	}
+}
+
+type permutationCycleModelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Keepers types.Map    `tfsdk:"keepers"`
+	Input   types.List   `tfsdk:"input"`
+	Seed    types.String `tfsdk:"seed"`
+	Salt    types.String `tfsdk:"salt"`
+	Result  types.List   `tfsdk:"result"`
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestAccResourcePermutationCycle(t *testing.T) {
+	checks := make([]resource.TestCheckFunc, 0, 10)
+	for i := 0; i < 10; i++ {
+		checks = append(checks, testAccResourcePermutationCycleCheckDerangement(fmt.Sprintf("random_permutation_cycle.cycle.%d", i), 5))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_permutation_cycle" "cycle" {
+							count = 10
+							input = ["0", "1", "2", "3", "4"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourcePermutationCycle_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_permutation_cycle" "cycle" {
+							input = ["0", "1", "2", "3", "4"]
+							seed  = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_permutation_cycle.cycle", "result.0", "2"),
+					resource.TestCheckResourceAttr("random_permutation_cycle.cycle", "result.1", "0"),
+					resource.TestCheckResourceAttr("random_permutation_cycle.cycle", "result.2", "1"),
+					resource.TestCheckResourceAttr("random_permutation_cycle.cycle", "result.3", "4"),
+					resource.TestCheckResourceAttr("random_permutation_cycle.cycle", "result.4", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePermutationCycle_Pair(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_permutation_cycle" "pair" {
+							input = ["a", "b"]
+						}
+						resource "random_permutation_cycle" "empty" {
+							input = []
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_permutation_cycle.pair", "result.0", "b"),
+					resource.TestCheckResourceAttr("random_permutation_cycle.pair", "result.1", "a"),
+					resource.TestCheckResourceAttr("random_permutation_cycle.empty", "result.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePermutationCycle_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_permutation_cycle" "single" {
+							input = ["a"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The input list contains a single element, which cannot be moved from its`),
+			},
+			{
+				Config: `resource "random_permutation_cycle" "null" {
+							input = ["a", null]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The input list needs to contain strings only, got null at index 1.`),
+			},
+		},
+	})
+}
+
+func TestPermutationDerangement(t *testing.T) {
+	rand := random.NewRand("12345")
+
+	// There are 9 derangements of 4 indices, which are expected to be drawn equally often.
+	const draws = 90000
+	counts := make(map[string]int)
+	for i := 0; i < draws; i++ {
+		perm := permutationDerangement(rand, 4)
+		for j, v := range perm {
+			if v == j {
+				t.Fatalf("expected no index at its own position, got %v", perm)
+			}
+		}
+		counts[fmt.Sprint(perm)]++
+	}
+
+	if len(counts) != 9 {
+		t.Fatalf("expected 9 distinct derangements, got %d", len(counts))
+	}
+
+	for perm, count := range counts {
+		if share := float64(count) / draws; math.Abs(share-1.0/9) > 0.005 {
+			t.Errorf("expected derangement %s to be drawn with a share of %.3f, got %.3f", perm, 1.0/9, share)
+		}
+	}
+}
+
+// testAccResourcePermutationCycleCheckDerangement checks that the result of the resource is a permutation of the
+// indices 0 to length - 1, given as strings, in which no index is at its own position.
+func testAccResourcePermutationCycleCheckDerangement(name string, length int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		attrs := rs.Primary.Attributes
+
+		if got := attrs["result.#"]; got != fmt.Sprint(length) {
+			return fmt.Errorf("expected %d elements in result, got %s", length, got)
+		}
+
+		seen := make(map[string]bool, length)
+		for i := 0; i < length; i++ {
+			v := attrs[fmt.Sprintf("result.%d", i)]
+
+			if v == fmt.Sprint(i) {
+				return fmt.Errorf("element %s is at its own index", v)
+			}
+
+			if seen[v] {
+				return fmt.Errorf("element %s occurs more than once in result", v)
+			}
+			seen[v] = true
+		}
+
+		return nil
+	}
+}
@@ -3,14 +3,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
-	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
 var _ tfsdk.ResourceType = (*petResourceType)(nil)
@@ -38,14 +44,18 @@ func (r *petResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnos
 				},
 			},
 			"length": {
-				Description: "The length (in words) of the pet name. Defaults to 2",
-				Type:        types.Int64Type,
-				Optional:    true,
-				Computed:    true,
+				Description: "The length (in words) of the pet name, i.e. the number of words joined by " +
+					"`separator`, not counting `prefix`. The minimum value is 1. Defaults to 2",
+				Type:     types.Int64Type,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.Int64{Value: 2}),
 					planmodifiers.RequiresReplace(),
 				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
 			},
 			"prefix": {
 				Description:   "A string to prefix the name with.",
@@ -54,14 +64,88 @@ func (r *petResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnos
 				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
 			},
 			"separator": {
-				Description: "The character to separate words in the pet name. Defaults to \"-\"",
-				Type:        types.StringType,
-				Optional:    true,
-				Computed:    true,
+				Description: "The character to separate words in the pet name. Must be a single printable, " +
+					"non-whitespace ASCII character, or an empty string to concatenate the words. Defaults to \"-\"",
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
 					planmodifiers.DefaultValue(types.String{Value: "-"}),
 					planmodifiers.RequiresReplace(),
 				},
+				Validators: []tfsdk.AttributeValidator{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[[:graph:]]?$`),
+						"value must be empty or a single printable, non-whitespace ASCII character",
+					),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile pet names.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same pet name " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+			},
+			"salt": saltAttribute(),
+			"word_pools": {
+				Description: "Map of named, themed word pools to draw the pet name from instead of the " +
+					"built-in word lists. For each word of the pet name, a pool is chosen in proportion to its " +
+					"`weight`, and a word is then drawn from that pool's `words`. Words are not split into " +
+					"adverbs, adjectives and names when pools are used.",
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"weight": {
+						Description: "The relative weight with which this pool is chosen for each word. Must " +
+							"not be negative, and the weights of all pools must add up to more than zero.",
+						Type:     types.Int64Type,
+						Required: true,
+						Validators: []tfsdk.AttributeValidator{
+							int64validator.AtLeast(0),
+						},
+					},
+					"words": {
+						Description: "The words in this pool. Each word must be made up of ASCII letters and " +
+							"digits only.",
+						Type: types.ListType{
+							ElemType: types.StringType,
+						},
+						Required: true,
+						Validators: []tfsdk.AttributeValidator{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValuesAre(stringvalidator.RegexMatches(
+								regexp.MustCompile(`^[[:alnum:]]+$`),
+								"value must be a non-empty word of ASCII letters and digits",
+							)),
+						},
+					},
+				}),
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"adverb_count": {
+				Description: "The number of adverbs in the word list that pet names are drawn from. Adverbs " +
+					"are only used when `length` is greater than 2. Not set when `word_pools` is set.",
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"adjective_count": {
+				Description: "The number of adjectives in the word list that pet names are drawn from. Not " +
+					"set when `word_pools` is set.",
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+			"noun_count": {
+				Description: "The number of nouns (names) in the word list that pet names are drawn from. Not " +
+					"set when `word_pools` is set.",
+				Type:     types.Int64Type,
+				Computed: true,
 			},
 			"id": {
 				Description: "The random pet name.",
@@ -73,19 +157,30 @@ func (r *petResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnos
 }
 
 func (r *petResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return &petResource{}, nil
+	words := p.(*provider).words.PetWords()
+
+	var diags diag.Diagnostics
+	if len(words.Adverbs) == 0 || len(words.Adjectives) == 0 || len(words.Names) == 0 {
+		diags.AddError(
+			"Random Pet Word Lists Error",
+			"The word lists of the provider need to contain at least one adverb, one adjective and one name.",
+		)
+	}
+
+	return &petResource{
+		source: p.(*provider).source,
+		words:  words,
+	}, diags
 }
 
 var _ tfsdk.Resource = (*petResource)(nil)
 
-type petResource struct{}
+type petResource struct {
+	source *randSource
+	words  random.PetWords
+}
 
 func (r *petResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	// This is necessary to ensure each call to petname is properly randomised:
-	// the library uses `rand.Intn()` and does NOT seed `rand.Seed()` by default,
-	// so this call takes care of that.
-	petname.NonDeterministicMode()
-
 	var plan petModelV0
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -98,14 +193,44 @@ func (r *petResource) Create(ctx context.Context, req tfsdk.CreateResourceReques
 	separator := plan.Separator.Value
 	prefix := plan.Prefix.Value
 
-	pet := strings.ToLower(petname.Generate(int(length), separator))
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
 
 	pn := petModelV0{
 		Keepers:   plan.Keepers,
 		Length:    types.Int64{Value: length},
 		Separator: types.String{Value: separator},
+		Seed:      plan.Seed,
+		Salt:      plan.Salt,
+		WordPools: plan.WordPools,
+	}
+
+	var pet string
+
+	if plan.WordPools.Null {
+		words := r.words
+		pet = random.CreatePetName(rand, words, length, separator)
+
+		pn.AdverbCount.Value = int64(len(words.Adverbs))
+		pn.AdjectiveCount.Value = int64(len(words.Adjectives))
+		pn.NounCount.Value = int64(len(words.Names))
+	} else {
+		var err error
+		pet, err = random.CreatePooledPetName(rand, petWordPools(plan.WordPools), length, separator)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Create Random Pet Error",
+				fmt.Sprintf("The word_pools value is invalid: %s.", err),
+			)
+			return
+		}
+
+		pn.AdverbCount.Null = true
+		pn.AdjectiveCount.Null = true
+		pn.NounCount.Null = true
 	}
 
+	pet = strings.ToLower(pet)
+
 	if prefix != "" {
 		pet = fmt.Sprintf("%s%s%s", prefix, separator, pet)
 		pn.Prefix.Value = prefix
@@ -113,7 +238,7 @@ func (r *petResource) Create(ctx context.Context, req tfsdk.CreateResourceReques
 		pn.Prefix.Null = true
 	}
 
-	pn.ID.Value = pet
+	pn.ID = resultID(pet)
 
 	diags = resp.State.Set(ctx, pn)
 	resp.Diagnostics.Append(diags...)
@@ -136,10 +261,42 @@ func (r *petResource) Update(ctx context.Context, req tfsdk.UpdateResourceReques
 func (r *petResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
 }
 
+// petWordPools converts the word_pools map into word pools, sorted by name so that the same seed always draws the
+// same words regardless of map iteration order.
+func petWordPools(wordPools types.Map) []random.PetWordPool {
+	names := make([]string, 0, len(wordPools.Elems))
+	for name := range wordPools.Elems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pools := make([]random.PetWordPool, 0, len(names))
+	for _, name := range names {
+		attrs := wordPools.Elems[name].(types.Object).Attrs
+
+		pool := random.PetWordPool{
+			Weight: attrs["weight"].(types.Int64).Value,
+		}
+		for _, w := range attrs["words"].(types.List).Elems {
+			pool.Words = append(pool.Words, w.(types.String).Value)
+		}
+
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
+
 type petModelV0 struct {
-	ID        types.String `tfsdk:"id"`
-	Keepers   types.Map    `tfsdk:"keepers"`
-	Length    types.Int64  `tfsdk:"length"`
-	Prefix    types.String `tfsdk:"prefix"`
-	Separator types.String `tfsdk:"separator"`
+	ID             types.String `tfsdk:"id"`
+	Keepers        types.Map    `tfsdk:"keepers"`
+	Length         types.Int64  `tfsdk:"length"`
+	Prefix         types.String `tfsdk:"prefix"`
+	Separator      types.String `tfsdk:"separator"`
+	Seed           types.String `tfsdk:"seed"`
+	Salt           types.String `tfsdk:"salt"`
+	WordPools      types.Map    `tfsdk:"word_pools"`
+	AdverbCount    types.Int64  `tfsdk:"adverb_count"`
+	AdjectiveCount types.Int64  `tfsdk:"adjective_count"`
+	NounCount      types.Int64  `tfsdk:"noun_count"`
 }
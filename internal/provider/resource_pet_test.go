@@ -6,7 +6,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
 func TestAccResourcePet(t *testing.T) {
@@ -40,6 +44,36 @@ func TestAccResourcePet_Length(t *testing.T) {
 	})
 }
 
+func TestAccResourcePet_LengthOne(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							length = 1
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_pet.pet_1", "id", testCheckPetLen("-", 1)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_LengthErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
 func TestAccResourcePet_Prefix(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories(),
@@ -74,6 +108,164 @@ func TestAccResourcePet_Separator(t *testing.T) {
 	})
 }
 
+func TestAccResourcePet_EmptySeparator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							length = 3
+  							separator = ""
+  							prefix = "consul"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_pet.pet_1", "id", regexp.MustCompile(`^consul[a-z]+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_SeparatorErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							separator = "--"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be empty or a single printable, non-whitespace ASCII character`),
+			},
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							separator = " "
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be empty or a single printable, non-whitespace ASCII character`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_WordListCounts(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_pet.pet_1", "adverb_count", "261"),
+					resource.TestCheckResourceAttr("random_pet.pet_1", "adjective_count", "449"),
+					resource.TestCheckResourceAttr("random_pet.pet_1", "noun_count", "456"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							seed = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_pet.pet_1", "id", "together-bullfrog"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_WordPools(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							length = 4
+  							seed   = "12345"
+  							word_pools = {
+  								space = {
+  									weight = 3
+  									words  = ["comet", "nebula", "orbit"]
+  								}
+  								sea = {
+  									weight = 1
+  									words  = ["otter", "kelp"]
+  								}
+  								never = {
+  									weight = 0
+  									words  = ["unused"]
+  								}
+  							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_pet.pet_1", "id", "nebula-nebula-orbit-otter"),
+					resource.TestMatchResourceAttr("random_pet.pet_1", "id", regexp.MustCompile(`^((comet|nebula|orbit|otter|kelp)(-|$)){4}$`)),
+					resource.TestCheckNoResourceAttr("random_pet.pet_1", "adverb_count"),
+					resource.TestCheckNoResourceAttr("random_pet.pet_1", "adjective_count"),
+					resource.TestCheckNoResourceAttr("random_pet.pet_1", "noun_count"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_WordPoolsErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							word_pools = {
+  								space = {
+  									weight = 0
+  									words  = ["comet"]
+  								}
+  							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*The word_pools value is invalid: the weights of the word pools need to add up\nto more than zero.`),
+			},
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							word_pools = {
+  								space = {
+  									weight = 1
+  									words  = ["red giant"]
+  								}
+  							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be a non-empty word of ASCII letters and digits`),
+			},
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							word_pools = {
+  								space = {
+  									weight = 1
+  									words  = []
+  								}
+  							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*List must contain at least 1 elements, got: 0`),
+			},
+			{
+				Config: `resource "random_pet" "pet_1" {
+  							word_pools = {
+  								space = {
+  									weight = -1
+  									words  = ["comet"]
+  								}
+  							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 0, got: -1`),
+			},
+		},
+	})
+}
+
 func TestAccResourcePet_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
@@ -119,3 +311,55 @@ func testCheckPetLen(separator string, expectedLen int) func(input string) error
 		return nil
 	}
 }
+
+// stubWordLists is a WordListProvider returning fixed word lists.
+type stubWordLists random.PetWords
+
+func (s stubWordLists) PetWords() random.PetWords {
+	return random.PetWords(s)
+}
+
+func stubWordListsProviderFactories(words stubWordLists) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"random": providerserver.NewProtocol6WithError(NewWithWordLists(words)),
+	}
+}
+
+func TestAccResourcePet_WordListProvider(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: stubWordListsProviderFactories(stubWordLists{
+			Adverbs:    []string{"quietly"},
+			Adjectives: []string{"brave", "brave"},
+			Names:      []string{"otter"},
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet" {
+							length = 3
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_pet.pet", "id", "quietly-brave-otter"),
+					resource.TestCheckResourceAttr("random_pet.pet", "adverb_count", "1"),
+					resource.TestCheckResourceAttr("random_pet.pet", "adjective_count", "2"),
+					resource.TestCheckResourceAttr("random_pet.pet", "noun_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_WordListProviderEmpty(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: stubWordListsProviderFactories(stubWordLists{
+			Adverbs:    []string{"quietly"},
+			Adjectives: []string{"brave"},
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet" {
+						}`,
+				ExpectError: regexp.MustCompile(`The word lists of the provider need to contain at least one adverb, one\nadjective and one name`),
+			},
+		},
+	})
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+)
+
+var (
+	phoneNumberCountries = stringEnum{"GB", "US"}
+	phoneNumberFormats   = stringEnum{"e164", "national"}
+)
+
+// phoneNumberPlan describes the numbers of a country that are reserved for fictional use, e.g. in films, so that
+// the generated numbers cannot be dialled.
+type phoneNumberPlan struct {
+	countryCode string
+	// draw returns the area code and subscriber number of a fictional number.
+	draw func(rand *rand.Rand) (areaCode, subscriber string)
+	// national returns a number as it is written for dialling within the country.
+	national func(areaCode, subscriber string) string
+}
+
+// phoneNumberPlans holds the plan of every country in phoneNumberCountries.
+var phoneNumberPlans = map[string]phoneNumberPlan{
+	// Ofcom reserves 07700 900000 to 07700 900999 for drama.
+	"GB": {
+		countryCode: "44",
+		draw: func(rand *rand.Rand) (string, string) {
+			return "7700", fmt.Sprintf("900%03d", rand.Intn(1000))
+		},
+		national: func(areaCode, subscriber string) string {
+			return fmt.Sprintf("0%s %s", areaCode, subscriber)
+		},
+	},
+	// The North American Numbering Plan reserves 555-0100 to 555-0199 for fictional use in every area code.
+	"US": {
+		countryCode: "1",
+		draw: func(rand *rand.Rand) (string, string) {
+			return phoneNumberNANPAreaCode(rand), fmt.Sprintf("55501%02d", rand.Intn(100))
+		},
+		national: func(areaCode, subscriber string) string {
+			return fmt.Sprintf("(%s) %s-%s", areaCode, subscriber[:3], subscriber[3:])
+		},
+	},
+}
+
+var _ tfsdk.ResourceType = (*phoneNumberResourceType)(nil)
+
+type phoneNumberResourceType struct{}
+
+func (r *phoneNumberResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_phonenumber` generates fake phone numbers with the structure of real " +
+			"ones, e.g. for contact-list fixtures. Numbers are drawn from the ranges reserved for fictional " +
+			"use, so that they are intentionally not dialable: `555-0100` to `555-0199` in any valid area " +
+			"code of the North American Numbering Plan for `US`, and `07700 900000` to `07700 900999` for " +
+			"`GB`.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"country": {
+				Description: "The ISO 3166-1 alpha-2 code of the country whose numbering plan the number " +
+					"follows. " + phoneNumberCountries.Description() + " Default value is `US`.",
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.String{Value: "US"}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					phoneNumberCountries.Validator(),
+				},
+			},
+			"format": {
+				Description: "The format of `result`: `e164` for the international E.164 format, e.g. " +
+					"`+12125550123`, or `national` for the format used within the country, e.g. " +
+					"`(212) 555-0123` or `07700 900123`. " + phoneNumberFormats.Description() + " Default value " +
+					"is `e164`.",
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.String{Value: "e164"}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					phoneNumberFormats.Validator(),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile numbers.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same number " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The generated phone number in `format`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"e164": {
+				Description: "The generated phone number in the E.164 format, whichever `format` is set.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"country_code": {
+				Description: "The country calling code of the number, without a leading `+`, e.g. `1`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"area_code": {
+				Description: "The area code of the number, without a national trunk prefix, e.g. `212`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"subscriber_number": {
+				Description: "The digits of the number that follow its area code, e.g. `5550123`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *phoneNumberResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &phoneNumberResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*phoneNumberResource)(nil)
+
+type phoneNumberResource struct {
+	source *randSource
+}
+
+func (r *phoneNumberResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan phoneNumberModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	numberPlan, ok := phoneNumberPlans[plan.Country.Value]
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Create Random Phone Number Error",
+			fmt.Sprintf("The country %q is not supported. %s", plan.Country.Value, phoneNumberCountries.Description()),
+		)
+		return
+	}
+
+	areaCode, subscriber := numberPlan.draw(r.source.newRand(plan.Seed.Value, plan.Salt.Value))
+	e164 := "+" + numberPlan.countryCode + areaCode + subscriber
+
+	result := e164
+	if plan.Format.Value == "national" {
+		result = numberPlan.national(areaCode, subscriber)
+	}
+
+	p := phoneNumberModelV0{
+		ID:               staticID(),
+		Keepers:          plan.Keepers,
+		Country:          plan.Country,
+		Format:           plan.Format,
+		Seed:             plan.Seed,
+		Salt:             plan.Salt,
+		Result:           types.String{Value: result},
+		E164:             types.String{Value: e164},
+		CountryCode:      types.String{Value: numberPlan.countryCode},
+		AreaCode:         types.String{Value: areaCode},
+		SubscriberNumber: types.String{Value: subscriber},
+	}
+
+	diags = resp.State.Set(ctx, p)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *phoneNumberResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *phoneNumberResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *phoneNumberResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// phoneNumberNANPAreaCode returns an area code drawn uniformly from the 712 that the North American Numbering Plan
+// allows: three digits NXX, where N is 2 to 9 and the middle digit is not 9, which is reserved for expansion,
+// excluding the N11 codes used for services such as 911.
+func phoneNumberNANPAreaCode(rand *rand.Rand) string {
+	for {
+		n := 200 + rand.Intn(800)
+		if n/10%10 == 9 || n%100 == 11 {
+			continue
+		}
+
+		return strconv.Itoa(n)
+	}
+}
+
+type phoneNumberModelV0 struct {
+	ID               types.String `tfsdk:"id"`
+	Keepers          types.Map    `tfsdk:"keepers"`
+	Country          types.String `tfsdk:"country"`
+	Format           types.String `tfsdk:"format"`
+	Seed             types.String `tfsdk:"seed"`
+	Salt             types.String `tfsdk:"salt"`
+	Result           types.String `tfsdk:"result"`
+	E164             types.String `tfsdk:"e164"`
+	CountryCode      types.String `tfsdk:"country_code"`
+	AreaCode         types.String `tfsdk:"area_code"`
+	SubscriberNumber types.String `tfsdk:"subscriber_number"`
+}
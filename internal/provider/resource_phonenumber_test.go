@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePhoneNumber(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_phonenumber" "us" {
+							count = 10
+						}
+						resource "random_phonenumber" "gb" {
+							country = "GB"
+							format  = "national"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_phonenumber.us.0", "result", regexp.MustCompile(`^\+1[2-9][0-8][0-9]55501[0-9]{2}$`)),
+					resource.TestCheckResourceAttrPair("random_phonenumber.us.0", "e164", "random_phonenumber.us.0", "result"),
+					resource.TestCheckResourceAttr("random_phonenumber.us.0", "country", "US"),
+					resource.TestCheckResourceAttr("random_phonenumber.us.0", "format", "e164"),
+					resource.TestCheckResourceAttr("random_phonenumber.us.0", "country_code", "1"),
+					resource.TestMatchResourceAttr("random_phonenumber.us.9", "subscriber_number", regexp.MustCompile(`^55501[0-9]{2}$`)),
+					resource.TestMatchResourceAttr("random_phonenumber.gb", "result", regexp.MustCompile(`^07700 900[0-9]{3}$`)),
+					resource.TestMatchResourceAttr("random_phonenumber.gb", "e164", regexp.MustCompile(`^\+447700900[0-9]{3}$`)),
+					resource.TestCheckResourceAttr("random_phonenumber.gb", "country_code", "44"),
+					resource.TestCheckResourceAttr("random_phonenumber.gb", "area_code", "7700"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePhoneNumber_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_phonenumber" "phone" {
+							format = "national"
+							seed   = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_phonenumber.phone", "result", "(883) 555-0143"),
+					resource.TestCheckResourceAttr("random_phonenumber.phone", "e164", "+18835550143"),
+					resource.TestCheckResourceAttr("random_phonenumber.phone", "area_code", "883"),
+					resource.TestCheckResourceAttr("random_phonenumber.phone", "subscriber_number", "5550143"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePhoneNumber_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_phonenumber" "phone" {
+							country = "FR"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be one of: \["\\"GB\\"" "\\"US\\""\], got: "FR"`),
+			},
+			{
+				Config: `resource "random_phonenumber" "phone" {
+							format = "E.164"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be one of: \["\\"e164\\"" "\\"national\\""\], got: "E.164"`),
+			},
+		},
+	})
+}
+
+func TestPhoneNumberPlans(t *testing.T) {
+	for _, country := range phoneNumberCountries {
+		if _, ok := phoneNumberPlans[country]; !ok {
+			t.Errorf("no numbering plan for country %q", country)
+		}
+	}
+
+	if len(phoneNumberPlans) != len(phoneNumberCountries) {
+		t.Errorf("expected %d numbering plans, got %d", len(phoneNumberCountries), len(phoneNumberPlans))
+	}
+}
+
+func TestPhoneNumberNANPAreaCode(t *testing.T) {
+	valid := regexp.MustCompile(`^[2-9][0-8][0-9]$`)
+	rand := rand.New(rand.NewSource(1))
+
+	seen := make(map[string]bool)
+	for i := 0; i < 20000; i++ {
+		code := phoneNumberNANPAreaCode(rand)
+		if !valid.MatchString(code) || code[1:] == "11" {
+			t.Fatalf("invalid area code %q", code)
+		}
+
+		seen[code] = true
+	}
+
+	if len(seen) != 712 {
+		t.Errorf("expected all 712 area codes to be drawn, got %d", len(seen))
+	}
+}
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+)
+
+// scheduleField describes a field of a cron expression and the values it accepts.
+type scheduleField struct {
+	name     string
+	min, max int
+}
+
+// scheduleFields are the fields of a cron expression, in order. A day_of_week of 7 is Sunday, like 0.
+var scheduleFields = []scheduleField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day_of_month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day_of_week", min: 0, max: 7},
+}
+
+var _ tfsdk.ResourceType = (*scheduleResourceType)(nil)
+
+type scheduleResourceType struct{}
+
+func (r *scheduleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	fixedField := func(name, example string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			Description: fmt.Sprintf("The %s field of the cron expression, which is not drawn, e.g. `%s`. ", name, example) +
+				"Fields consist of `*`, values, ranges such as `1-5` and steps such as `*/2`, or lists of " +
+				"these separated by commas. Default value is `*`.",
+			Type:     types.StringType,
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []tfsdk.AttributePlanModifier{
+				planmodifiers.DefaultValue(types.String{Value: "*"}),
+				planmodifiers.RequiresReplace(),
+			},
+		}
+	}
+
+	hourField := fixedField("hour", "3")
+	hourField.Description += " Cannot be used with `hour_min` and `hour_max`."
+	hourField.Validators = []tfsdk.AttributeValidator{
+		schemavalidator.ConflictsWith(path.MatchRoot("hour_min"), path.MatchRoot("hour_max")),
+	}
+
+	return tfsdk.Schema{
+		Description: "The resource `random_schedule` generates a cron expression with a random minute, and " +
+			"optionally a random hour, e.g. to spread out scheduled jobs instead of starting them all at " +
+			"once. All other fields of the expression are fixed.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"minute_min": {
+				Description: "The lowest minute that can be drawn, between `0` and `59`. Default value is `0`.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Int64{Value: 0}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(0, 59),
+				},
+			},
+			"minute_max": {
+				Description: "The highest minute that can be drawn, between `0` and `59`. Must not be lower " +
+					"than `minute_min`. Default value is `59`.",
+				Type:     types.Int64Type,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Int64{Value: 59}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(0, 59),
+				},
+			},
+			"hour_min": {
+				Description: "The lowest hour that can be drawn, between `0` and `23`. When set, the hour is " +
+					"drawn as well. Requires `hour_max`.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(0, 23),
+					schemavalidator.AlsoRequires(path.MatchRoot("hour_max")),
+				},
+			},
+			"hour_max": {
+				Description: "The highest hour that can be drawn, between `0` and `23`. Must not be lower than " +
+					"`hour_min`. Requires `hour_min`.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(0, 23),
+					schemavalidator.AlsoRequires(path.MatchRoot("hour_min")),
+				},
+			},
+			"hour":         hourField,
+			"day_of_month": fixedField("day_of_month", "1"),
+			"month":        fixedField("month", "*/3"),
+			"day_of_week":  fixedField("day_of_week", "1-5"),
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile results.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same result " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The cron expression of five fields separated by spaces, e.g. `17 3 * * *`.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"fields": {
+				Description: "The fields of `result` parsed by name: `minute`, `hour`, `day_of_month`, `month` " +
+					"and `day_of_week`.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *scheduleResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &scheduleResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*scheduleResource)(nil)
+
+type scheduleResource struct {
+	source *randSource
+}
+
+func (r *scheduleResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan scheduleModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.MinuteMin.Value > plan.MinuteMax.Value {
+		resp.Diagnostics.AddError(
+			"Create Random Schedule Error",
+			fmt.Sprintf("The minute_min value (%d) needs to be lower than or equal to the minute_max value (%d).",
+				plan.MinuteMin.Value, plan.MinuteMax.Value),
+		)
+		return
+	}
+
+	if plan.HourMin.Value > plan.HourMax.Value {
+		resp.Diagnostics.AddError(
+			"Create Random Schedule Error",
+			fmt.Sprintf("The hour_min value (%d) needs to be lower than or equal to the hour_max value (%d).",
+				plan.HourMin.Value, plan.HourMax.Value),
+		)
+		return
+	}
+
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
+
+	minute := plan.MinuteMin.Value + rand.Int63n(plan.MinuteMax.Value-plan.MinuteMin.Value+1)
+	hour := plan.Hour.Value
+	if !plan.HourMin.Null {
+		hour = strconv.FormatInt(plan.HourMin.Value+rand.Int63n(plan.HourMax.Value-plan.HourMin.Value+1), 10)
+	}
+
+	result := strings.Join([]string{
+		strconv.FormatInt(minute, 10), hour, plan.DayOfMonth.Value, plan.Month.Value, plan.DayOfWeek.Value,
+	}, " ")
+
+	fields, err := scheduleParse(result)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Schedule Error",
+			fmt.Sprintf("The cron expression %q is invalid: %s.", result, err),
+		)
+		return
+	}
+
+	s := scheduleModelV0{
+		ID:         staticID(),
+		Keepers:    plan.Keepers,
+		MinuteMin:  plan.MinuteMin,
+		MinuteMax:  plan.MinuteMax,
+		HourMin:    plan.HourMin,
+		HourMax:    plan.HourMax,
+		Hour:       plan.Hour,
+		DayOfMonth: plan.DayOfMonth,
+		Month:      plan.Month,
+		DayOfWeek:  plan.DayOfWeek,
+		Seed:       plan.Seed,
+		Salt:       plan.Salt,
+		Result:     types.String{Value: result},
+		Fields: types.Map{
+			ElemType: types.StringType,
+			Elems:    make(map[string]attr.Value, len(fields)),
+		},
+	}
+
+	for i, field := range scheduleFields {
+		s.Fields.Elems[field.name] = types.String{Value: fields[i]}
+	}
+
+	diags = resp.State.Set(ctx, s)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *scheduleResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *scheduleResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *scheduleResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// scheduleParse splits a cron expression of five fields separated by spaces into its fields, and returns an error
+// when it has a different number of fields, or a field is malformed or out of bounds.
+func scheduleParse(expression string) ([]string, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != len(scheduleFields) {
+		return nil, fmt.Errorf("the expression needs to contain %d fields, got %d", len(scheduleFields), len(fields))
+	}
+
+	for i, field := range scheduleFields {
+		if err := scheduleParseField(fields[i], field); err != nil {
+			return nil, fmt.Errorf("the %s field %q is invalid: %w", field.name, fields[i], err)
+		}
+	}
+
+	return fields, nil
+}
+
+// scheduleParseField returns an error unless value is a list of `*`, values or ranges separated by commas, each of
+// which may be followed by a step such as `/2`, and all values lie within the bounds of field.
+func scheduleParseField(value string, field scheduleField) error {
+	for _, part := range strings.Split(value, ",") {
+		span := part
+		if i := strings.IndexByte(part, '/'); i != -1 {
+			span = part[:i]
+
+			step := part[i+1:]
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return fmt.Errorf("the step %q needs to be a positive number", step)
+			}
+		}
+
+		if span == "*" {
+			continue
+		}
+
+		first, last := span, span
+		if i := strings.IndexByte(span, '-'); i != -1 {
+			first, last = span[:i], span[i+1:]
+		}
+
+		low, errLow := strconv.Atoi(first)
+		high, errHigh := strconv.Atoi(last)
+		if errLow != nil || errHigh != nil {
+			return fmt.Errorf("%q needs to be *, a value or a range of values", span)
+		}
+
+		if low < field.min || high > field.max || low > high {
+			return fmt.Errorf("%q needs to lie between %d and %d", span, field.min, field.max)
+		}
+	}
+
+	return nil
+}
+
+type scheduleModelV0 struct {
+	ID         types.String `tfsdk:"id"`
+	Keepers    types.Map    `tfsdk:"keepers"`
+	MinuteMin  types.Int64  `tfsdk:"minute_min"`
+	MinuteMax  types.Int64  `tfsdk:"minute_max"`
+	HourMin    types.Int64  `tfsdk:"hour_min"`
+	HourMax    types.Int64  `tfsdk:"hour_max"`
+	Hour       types.String `tfsdk:"hour"`
+	DayOfMonth types.String `tfsdk:"day_of_month"`
+	Month      types.String `tfsdk:"month"`
+	DayOfWeek  types.String `tfsdk:"day_of_week"`
+	Seed       types.String `tfsdk:"seed"`
+	Salt       types.String `tfsdk:"salt"`
+	Result     types.String `tfsdk:"result"`
+	Fields     types.Map    `tfsdk:"fields"`
+}
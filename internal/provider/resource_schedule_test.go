@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSchedule(t *testing.T) {
+	checks := make([]resource.TestCheckFunc, 0, 30)
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("random_schedule.daily.%d", i)
+		checks = append(checks,
+			resource.TestMatchResourceAttr(name, "result", regexp.MustCompile(`^(1[5-9]|2[0-9]|30) 3 \* \* 1-5$`)),
+			resource.TestMatchResourceAttr(name, "fields.minute", regexp.MustCompile(`^(1[5-9]|2[0-9]|30)$`)),
+			resource.TestCheckResourceAttr(name, "fields.hour", "3"),
+		)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_schedule" "daily" {
+							count       = 10
+							minute_min  = 15
+							minute_max  = 30
+							hour        = "3"
+							day_of_week = "1-5"
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourceSchedule_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_schedule" "hourly" {
+							seed = "12345"
+						}
+						resource "random_schedule" "nightly" {
+							hour_min     = 1
+							hour_max     = 4
+							day_of_month = "1,15"
+							seed         = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_schedule.hourly", "result", "38 * * * *"),
+					resource.TestCheckResourceAttr("random_schedule.hourly", "fields.hour", "*"),
+					resource.TestCheckResourceAttr("random_schedule.nightly", "result", "38 3 1,15 * *"),
+					resource.TestCheckResourceAttr("random_schedule.nightly", "fields.%", "5"),
+					resource.TestCheckResourceAttr("random_schedule.nightly", "fields.day_of_month", "1,15"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSchedule_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_schedule" "schedule" {
+							minute_min = 40
+							minute_max = 20
+						}`,
+				ExpectError: regexp.MustCompile(`.*The minute_min value \(40\) needs to be lower than or equal to the minute_max\nvalue \(20\).`),
+			},
+			{
+				Config: `resource "random_schedule" "schedule" {
+							hour_min = 4
+						}`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "random_schedule" "schedule" {
+							hour     = "3"
+							hour_min = 1
+							hour_max = 4
+						}`,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "random_schedule" "schedule" {
+							month = "13"
+						}`,
+				ExpectError: regexp.MustCompile(`.*the month field "13" is\ninvalid: "13" needs to lie between 1 and 12.`),
+			},
+		},
+	})
+}
+
+func TestScheduleParse(t *testing.T) {
+	testCases := map[string]struct {
+		expression    string
+		expectedError bool
+	}{
+		"wildcards":         {expression: "* * * * *"},
+		"values":            {expression: "0 23 31 12 7"},
+		"ranges and steps":  {expression: "*/15 9-17 1-31/2 */3 1-5"},
+		"lists":             {expression: "5,35 1,2,3-4 1,15 1,7 0,6"},
+		"too few fields":    {expression: "0 0 * *", expectedError: true},
+		"too many fields":   {expression: "0 0 * * * *", expectedError: true},
+		"minute too large":  {expression: "60 * * * *", expectedError: true},
+		"day of month zero": {expression: "0 0 0 * *", expectedError: true},
+		"reversed range":    {expression: "0 5-1 * * *", expectedError: true},
+		"zero step":         {expression: "*/0 * * * *", expectedError: true},
+		"name":              {expression: "0 0 * * MON", expectedError: true},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			_, err := scheduleParse(testCase.expression)
+
+			if testCase.expectedError && err == nil {
+				t.Fatalf("expected an error for %q", testCase.expression)
+			}
+
+			if !testCase.expectedError && err != nil {
+				t.Fatalf("unexpected error for %q: %s", testCase.expression, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+)
+
+var _ tfsdk.ResourceType = (*sequenceResourceType)(nil)
+
+type sequenceResourceType struct{}
+
+func (r *sequenceResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_sequence` maintains a monotonically increasing counter that advances " +
+			"by a random step, within the range described by the `min_step` and `max_step` attributes, each " +
+			"time the `trigger` map changes.\n" +
+			"\n" +
+			"Unlike the other resources in this provider, `random_sequence` is *stateful*: each new value is " +
+			"derived from the value previously stored in state, rather than being generated from scratch. " +
+			"Recreating the resource (e.g. by changing `keepers` or `start`) resets the sequence to `start`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"trigger": {
+				Description: "Arbitrary map of values that, when changed, will advance `current` by a random " +
+					"step. Unlike `keepers`, changing `trigger` updates the resource in-place.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+			},
+			"start": {
+				Description: "The initial value of the sequence. Defaults to `0`.",
+				Type:        types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.Int64{Value: 0}),
+					planmodifiers.RequiresReplace(),
+				},
+			},
+			"min_step": {
+				Description: "The minimum inclusive amount by which the sequence advances. The minimum value is 1.",
+				Type:        types.Int64Type,
+				Required:    true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_step": {
+				Description: "The maximum inclusive amount by which the sequence advances. Must be greater than " +
+					"or equal to `min_step`.",
+				Type:     types.Int64Type,
+				Required: true,
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"current": {
+				Description: "The current value of the sequence.",
+				Type:        types.Int64Type,
+				Computed:    true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *sequenceResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &sequenceResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var (
+	_ tfsdk.Resource               = (*sequenceResource)(nil)
+	_ tfsdk.ResourceWithModifyPlan = (*sequenceResource)(nil)
+)
+
+type sequenceResource struct {
+	source *randSource
+}
+
+func (r *sequenceResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan sequenceModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.MaxStep.Value < plan.MinStep.Value {
+		resp.Diagnostics.AddError(
+			"Create Random Sequence Error",
+			"The minimum step (min_step) value needs to be smaller than or equal to maximum step (max_step) value.",
+		)
+		return
+	}
+
+	s := sequenceModelV0{
+		ID:      staticID(),
+		Keepers: plan.Keepers,
+		Trigger: plan.Trigger,
+		Start:   types.Int64{Value: plan.Start.Value},
+		MinStep: types.Int64{Value: plan.MinStep.Value},
+		MaxStep: types.Int64{Value: plan.MaxStep.Value},
+		Current: types.Int64{Value: plan.Start.Value},
+	}
+
+	diags = resp.State.Set(ctx, s)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *sequenceResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update advances the sequence by a random step when `trigger` has changed. Changes to `min_step` and `max_step`
+// alone are stored without advancing, and only affect subsequent steps.
+func (r *sequenceResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state sequenceModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	minStep := plan.MinStep.Value
+	maxStep := plan.MaxStep.Value
+
+	if maxStep < minStep {
+		resp.Diagnostics.AddError(
+			"Update Random Sequence Error",
+			"The minimum step (min_step) value needs to be smaller than or equal to maximum step (max_step) value.",
+		)
+		return
+	}
+
+	current := state.Current.Value
+
+	if !plan.Trigger.Equal(state.Trigger) {
+		rand := r.source.newRand("", "")
+		step := rand.Int63n((maxStep+1)-minStep) + minStep
+
+		if current > math.MaxInt64-step {
+			resp.Diagnostics.AddError(
+				"Update Random Sequence Error",
+				fmt.Sprintf("Advancing the sequence from %d by %d would overflow a 64-bit integer.", current, step),
+			)
+			return
+		}
+
+		current += step
+	}
+
+	s := sequenceModelV0{
+		ID:      state.ID,
+		Keepers: plan.Keepers,
+		Trigger: plan.Trigger,
+		Start:   types.Int64{Value: plan.Start.Value},
+		MinStep: types.Int64{Value: minStep},
+		MaxStep: types.Int64{Value: maxStep},
+		Current: types.Int64{Value: current},
+	}
+
+	diags = resp.State.Set(ctx, s)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *sequenceResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// ModifyPlan keeps current and id from the prior state when trigger has not changed, as Update then stores them
+// unchanged. Otherwise the framework plans them as unknown for any in-place update, e.g. of min_step alone, which
+// would replace every resource referencing current.
+func (r *sequenceResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// The state is null when the resource is being created, and the plan is null when it is being destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state sequenceModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("id"), state.ID)
+	resp.Diagnostics.Append(diags...)
+
+	if plan.Trigger.Unknown || !plan.Trigger.Equal(state.Trigger) {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("current"), state.Current)
+	resp.Diagnostics.Append(diags...)
+}
+
+type sequenceModelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Keepers types.Map    `tfsdk:"keepers"`
+	Trigger types.Map    `tfsdk:"trigger"`
+	Start   types.Int64  `tfsdk:"start"`
+	MinStep types.Int64  `tfsdk:"min_step"`
+	MaxStep types.Int64  `tfsdk:"max_step"`
+	Current types.Int64  `tfsdk:"current"`
+}
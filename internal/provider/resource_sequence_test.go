@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSequence(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							start    = 10
+							min_step = 5
+							max_step = 5
+							trigger  = {
+								release = "1"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "current", "10"),
+				),
+			},
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							start    = 10
+							min_step = 5
+							max_step = 5
+							trigger  = {
+								release = "2"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "current", "15"),
+				),
+			},
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							start    = 10
+							min_step = 5
+							max_step = 5
+							trigger  = {
+								release = "3"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "current", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSequence_StepRange(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							min_step = 1
+							max_step = 100
+							trigger  = {
+								release = "1"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "start", "0"),
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "current", "0"),
+				),
+			},
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							min_step = 1
+							max_step = 100
+							trigger  = {
+								release = "2"
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_sequence.sequence_1", "current", testCheckInt64Between(1, 100)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSequence_StepChangeDoesNotAdvance(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							start    = 3
+							min_step = 1
+							max_step = 1
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "current", "3"),
+				),
+			},
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							start    = 3
+							min_step = 2
+							max_step = 7
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "min_step", "2"),
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "max_step", "7"),
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "current", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSequence_StepChangeKeepsDependents(t *testing.T) {
+	var dependent string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							min_step = 1
+							max_step = 1
+						}
+						resource "random_string" "dependent" {
+							length  = 12
+							keepers = {
+								current = random_sequence.sequence_1.current
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttrCapture("random_string.dependent", "result", &dependent),
+				),
+			},
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							min_step = 2
+							max_step = 7
+						}
+						resource "random_string" "dependent" {
+							length  = 12
+							keepers = {
+								current = random_sequence.sequence_1.current
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_sequence.sequence_1", "current", "0"),
+					testAccCheckAttrEquals("random_string.dependent", "result", &dependent),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSequence_StepErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							min_step = 5
+							max_step = 1
+						}`,
+				ExpectError: regexp.MustCompile(`.*The minimum step \(min_step\) value needs to be smaller than or equal to\nmaximum step \(max_step\) value.`),
+			},
+			{
+				Config: `resource "random_sequence" "sequence_1" {
+							min_step = 0
+							max_step = 1
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
+func testCheckInt64Between(min, max int64) func(input string) error {
+	return func(input string) error {
+		v, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		if v < min || v > max {
+			return fmt.Errorf("expected value between %d and %d, got %d", min, max, v)
+		}
+
+		return nil
+	}
+}
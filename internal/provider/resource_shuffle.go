@@ -2,9 +2,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -16,6 +22,9 @@ var _ tfsdk.ResourceType = (*shuffleResourceType)(nil)
 type shuffleResourceType struct{}
 
 func (r *shuffleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	salt := saltAttribute()
+	salt.PlanModifiers = []tfsdk.AttributePlanModifier{shuffleRequiresReplace()}
+
 	return tfsdk.Schema{
 		Description: "The resource `random_shuffle` generates a random permutation of a list of strings " +
 			"given as an argument.",
@@ -28,7 +37,7 @@ func (r *shuffleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Dia
 				},
 				Optional: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
-					tfsdk.RequiresReplace(),
+					shuffleRequiresReplace(),
 				},
 			},
 			"seed": {
@@ -41,7 +50,21 @@ func (r *shuffleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Dia
 				Type:     types.StringType,
 				Optional: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
-					tfsdk.RequiresReplace(),
+					shuffleRequiresReplace(),
+				},
+			},
+			"salt": salt,
+			"crypto": {
+				Description: "When `true`, the permutation is drawn from a cryptographic random number " +
+					"generator, whatever the `default_source` of the provider, e.g. for security-sensitive " +
+					"orderings such as ballots. Cannot be used with `seed`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					shuffleRequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(path.MatchRoot("seed")),
 				},
 			},
 			"input": {
@@ -51,7 +74,30 @@ func (r *shuffleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Dia
 				},
 				Required: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
-					tfsdk.RequiresReplace(),
+					tfsdk.RequiresReplaceIf(
+						shuffleInputRequiresReplace,
+						"If the value of this attribute changes, Terraform will destroy and recreate the resource, "+
+							"unless the resource was imported and input is being set for the first time.",
+						"If the value of this attribute changes, Terraform will destroy and recreate the resource, "+
+							"unless the resource was imported and `input` is being set for the first time.",
+					),
+				},
+			},
+			"group_by": {
+				Description: "A list of group names, one for each element of `input`. When set, elements " +
+					"are only shuffled among the elements of their own group, and each group is kept " +
+					"contiguous in the result while the order of the groups is itself shuffled, e.g. for " +
+					"block randomization. Must have the same number of elements as `input`, and cannot be " +
+					"used with `result_count`.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					shuffleRequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(path.MatchRoot("result_count")),
 				},
 			},
 			"result_count": {
@@ -62,7 +108,44 @@ func (r *shuffleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Dia
 				Type:     types.Int64Type,
 				Optional: true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{
-					tfsdk.RequiresReplace(),
+					shuffleRequiresReplace(),
+				},
+			},
+			"pin_first": {
+				Description: "An element of `input` that is always placed first in `result`, with the " +
+					"remaining elements shuffled after it, e.g. a default choice. Cannot be used with `group_by`.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					shuffleRequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(path.MatchRoot("group_by")),
+				},
+			},
+			"pin_last": {
+				Description: "An element of `input` that is always placed last in `result`, with the " +
+					"remaining elements shuffled before it. When it is the same as `pin_first`, the element " +
+					"needs to occur at least twice in `input`. Cannot be used with `group_by`.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					shuffleRequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(path.MatchRoot("group_by")),
+				},
+			},
+			"head_size": {
+				Description: "The number of elements of `result` to place in `head`, with the remaining " +
+					"elements placed in `tail`. Must be between `0` and the number of elements in `result`.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					shuffleRequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
 				},
 			},
 			"result": {
@@ -71,6 +154,24 @@ func (r *shuffleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Dia
 					ElemType: types.StringType,
 				},
 				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"head": {
+				Description: "The first `head_size` elements of `result`. Only set when `head_size` is set.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+			"tail": {
+				Description: "The elements of `result` after the first `head_size`. Only set when " +
+					"`head_size` is set.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
 			},
 			"id": {
 				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
@@ -82,12 +183,19 @@ func (r *shuffleResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Dia
 }
 
 func (r *shuffleResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return &shuffleResource{}, nil
+	return &shuffleResource{
+		source: p.(*provider).source,
+	}, nil
 }
 
-var _ tfsdk.Resource = (*shuffleResource)(nil)
+var (
+	_ tfsdk.Resource                = (*shuffleResource)(nil)
+	_ tfsdk.ResourceWithImportState = (*shuffleResource)(nil)
+)
 
-type shuffleResource struct{}
+type shuffleResource struct {
+	source *randSource
+}
 
 func (r *shuffleResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	var plan shuffleModelV0
@@ -105,36 +213,71 @@ func (r *shuffleResource) Create(ctx context.Context, req tfsdk.CreateResourceRe
 		resultCount = int64(len(input.Elems))
 	}
 
-	result := make([]attr.Value, 0, resultCount)
+	if !plan.GroupBy.Null && len(plan.GroupBy.Elems) != len(input.Elems) {
+		resp.Diagnostics.AddError(
+			"Create Random Shuffle Error",
+			fmt.Sprintf("The group_by list needs to have the same number of elements as input (%d), got %d.",
+				len(input.Elems), len(plan.GroupBy.Elems)),
+		)
+		return
+	}
 
-	if len(input.Elems) > 0 {
-		rand := random.NewRand(seed)
+	rand := r.source.newRand(seed, plan.Salt.Value)
+	if plan.Crypto.Value {
+		rand = random.NewCryptoRand()
+	}
 
-		// Keep producing permutations until we fill our result
-	Batches:
-		for {
-			perm := rand.Perm(len(input.Elems))
+	var order []int
+	if plan.PinFirst.Null && plan.PinLast.Null {
+		order = shuffleOrder(rand, len(input.Elems), resultCount, plan.GroupBy)
+	} else {
+		var err error
+		order, err = shufflePinnedOrder(rand, input.Elems, resultCount, plan.PinFirst, plan.PinLast)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Create Random Shuffle Error",
+				fmt.Sprintf("Unable to pin elements of the result: %s.", err),
+			)
+			return
+		}
+	}
 
-			for _, i := range perm {
-				result = append(result, input.Elems[i])
+	result := make([]attr.Value, 0, resultCount)
+	for _, i := range order {
+		result = append(result, input.Elems[i])
+	}
 
-				if int64(len(result)) >= resultCount {
-					break Batches
-				}
-			}
-		}
+	if plan.HeadSize.Value > int64(len(result)) {
+		resp.Diagnostics.AddError(
+			"Create Random Shuffle Error",
+			fmt.Sprintf("The head_size value (%d) needs to be at most the number of elements in the result (%d).",
+				plan.HeadSize.Value, len(result)),
+		)
+		return
 	}
 
 	s := shuffleModelV0{
-		ID:      types.String{Value: "-"},
-		Keepers: plan.Keepers,
-		Input:   plan.Input,
+		ID:       staticID(),
+		Keepers:  plan.Keepers,
+		Crypto:   plan.Crypto,
+		Input:    plan.Input,
+		GroupBy:  plan.GroupBy,
+		PinFirst: plan.PinFirst,
+		PinLast:  plan.PinLast,
+		HeadSize: plan.HeadSize,
 		Result: types.List{
 			Unknown:  false,
 			Null:     false,
 			Elems:    result,
 			ElemType: types.StringType,
 		},
+		Head: types.List{ElemType: types.StringType, Null: true},
+		Tail: types.List{ElemType: types.StringType, Null: true},
+	}
+
+	if !plan.HeadSize.Null {
+		s.Head = types.List{Elems: result[:plan.HeadSize.Value], ElemType: types.StringType}
+		s.Tail = types.List{Elems: result[plan.HeadSize.Value:], ElemType: types.StringType}
 	}
 
 	if plan.Seed.Null {
@@ -143,6 +286,8 @@ func (r *shuffleResource) Create(ctx context.Context, req tfsdk.CreateResourceRe
 		s.Seed.Value = seed
 	}
 
+	s.Salt = plan.Salt
+
 	if plan.ResultCount.Null {
 		s.ResultCount.Null = true
 	} else {
@@ -160,9 +305,105 @@ func (r *shuffleResource) Create(ctx context.Context, req tfsdk.CreateResourceRe
 func (r *shuffleResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
 }
 
-// Update is intentionally left blank as all required and optional attributes force replacement of the resource
-// through the RequiresReplace AttributePlanModifier.
+// Update only occurs when `input` is set for the first time following an import, along with any other arguments in
+// the configuration, as all other changes to required and optional attributes force replacement of the resource. The
+// imported `result` is retained as long as it is consistent with the arguments.
 func (r *shuffleResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state shuffleModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := make(map[string]struct{}, len(plan.Input.Elems))
+	for _, v := range plan.Input.Elems {
+		input[v.(types.String).Value] = struct{}{}
+	}
+
+	for _, v := range state.Result.Elems {
+		if _, ok := input[v.(types.String).Value]; !ok {
+			resp.Diagnostics.AddError(
+				"Update Random Shuffle Error",
+				fmt.Sprintf("The imported result contains %q, which is not present in input.", v.(types.String).Value),
+			)
+			return
+		}
+	}
+
+	result := state.Result.Elems
+
+	if !plan.ResultCount.Null && plan.ResultCount.Value != int64(len(result)) {
+		resp.Diagnostics.AddError(
+			"Update Random Shuffle Error",
+			fmt.Sprintf("The imported result contains %d elements, which does not match result_count (%d).",
+				len(result), plan.ResultCount.Value),
+		)
+		return
+	}
+
+	if !plan.GroupBy.Null && len(plan.GroupBy.Elems) != len(plan.Input.Elems) {
+		resp.Diagnostics.AddError(
+			"Update Random Shuffle Error",
+			fmt.Sprintf("The group_by list needs to have the same number of elements as input (%d), got %d.",
+				len(plan.Input.Elems), len(plan.GroupBy.Elems)),
+		)
+		return
+	}
+
+	if !plan.PinFirst.Null && (len(result) == 0 || !result[0].Equal(plan.PinFirst)) {
+		resp.Diagnostics.AddError(
+			"Update Random Shuffle Error",
+			fmt.Sprintf("The imported result does not start with pin_first (%q).", plan.PinFirst.Value),
+		)
+		return
+	}
+
+	if !plan.PinLast.Null && (len(result) == 0 || !result[len(result)-1].Equal(plan.PinLast)) {
+		resp.Diagnostics.AddError(
+			"Update Random Shuffle Error",
+			fmt.Sprintf("The imported result does not end with pin_last (%q).", plan.PinLast.Value),
+		)
+		return
+	}
+
+	if plan.HeadSize.Value > int64(len(result)) {
+		resp.Diagnostics.AddError(
+			"Update Random Shuffle Error",
+			fmt.Sprintf("The head_size value (%d) needs to be at most the number of elements in the result (%d).",
+				plan.HeadSize.Value, len(result)),
+		)
+		return
+	}
+
+	state.Keepers = plan.Keepers
+	state.Seed = plan.Seed
+	state.Salt = plan.Salt
+	state.Crypto = plan.Crypto
+	state.Input = plan.Input
+	state.GroupBy = plan.GroupBy
+	state.ResultCount = plan.ResultCount
+	state.PinFirst = plan.PinFirst
+	state.PinLast = plan.PinLast
+	state.HeadSize = plan.HeadSize
+
+	if !plan.HeadSize.Null {
+		state.Head = types.List{Elems: result[:plan.HeadSize.Value], ElemType: types.StringType}
+		state.Tail = types.List{Elems: result[plan.HeadSize.Value:], ElemType: types.StringType}
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
@@ -170,11 +411,230 @@ func (r *shuffleResource) Update(ctx context.Context, req tfsdk.UpdateResourceRe
 func (r *shuffleResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
 }
 
+// ImportState populates result with the comma-separated elements of the import ID, in the order given. The input
+// is left null so that it can be taken from the configuration on the next apply without replacing the resource.
+func (r *shuffleResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	result := make([]attr.Value, 0)
+	if req.ID != "" {
+		for _, v := range strings.Split(req.ID, ",") {
+			result = append(result, types.String{Value: v})
+		}
+	}
+
+	state := shuffleModelV0{
+		ID:          staticID(),
+		Keepers:     types.Map{ElemType: types.StringType, Null: true},
+		Seed:        types.String{Null: true},
+		Salt:        types.String{Null: true},
+		Crypto:      types.Bool{Null: true},
+		Input:       types.List{ElemType: types.StringType, Null: true},
+		GroupBy:     types.List{ElemType: types.StringType, Null: true},
+		ResultCount: types.Int64{Null: true},
+		PinFirst:    types.String{Null: true},
+		PinLast:     types.String{Null: true},
+		HeadSize:    types.Int64{Null: true},
+		Result: types.List{
+			Elems:    result,
+			ElemType: types.StringType,
+		},
+		Head: types.List{ElemType: types.StringType, Null: true},
+		Tail: types.List{ElemType: types.StringType, Null: true},
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// shuffleOrder returns the indices of the n elements of the input, in the order in which they appear in the result.
+// Without groupBy, permutations are repeated until resultCount indices have been drawn. With groupBy, every index
+// appears exactly once and resultCount is ignored.
+func shuffleOrder(rand *rand.Rand, n int, resultCount int64, groupBy types.List) []int {
+	order := make([]int, 0, resultCount)
+
+	if !groupBy.Null {
+		for _, group := range shuffleGroups(rand, groupBy) {
+			for _, i := range rand.Perm(len(group)) {
+				order = append(order, group[i])
+			}
+		}
+
+		return order
+	}
+
+	if n == 0 {
+		return order
+	}
+
+	// Keep producing permutations until we fill our result
+	for {
+		for _, i := range rand.Perm(n) {
+			order = append(order, i)
+
+			if int64(len(order)) >= resultCount {
+				return order
+			}
+		}
+	}
+}
+
+// shufflePinnedOrder returns the indices of resultCount elements of input like shuffleOrder, with the element named
+// by pinFirst placed first and the element named by pinLast placed last, when set. Each pinned element is taken from
+// its first occurrence in input that is not already pinned, and the remaining elements are shuffled in between.
+func shufflePinnedOrder(rand *rand.Rand, input []attr.Value, resultCount int64, pinFirst, pinLast types.String) ([]int, error) {
+	pinned := make(map[int]struct{}, 2)
+	pin := func(attribute string, value types.String) (int, error) {
+		found := false
+
+		for i, v := range input {
+			if v.(types.String).Value != value.Value {
+				continue
+			}
+
+			found = true
+			if _, ok := pinned[i]; !ok {
+				pinned[i] = struct{}{}
+				return i, nil
+			}
+		}
+
+		if found {
+			return 0, fmt.Errorf("pin_first and pin_last are both %q, which needs to occur at least twice in input", value.Value)
+		}
+
+		return 0, fmt.Errorf("the %s value %q needs to be an element of input", attribute, value.Value)
+	}
+
+	var first, last []int
+	if !pinFirst.Null {
+		i, err := pin("pin_first", pinFirst)
+		if err != nil {
+			return nil, err
+		}
+		first = []int{i}
+	}
+
+	if !pinLast.Null {
+		i, err := pin("pin_last", pinLast)
+		if err != nil {
+			return nil, err
+		}
+		last = []int{i}
+	}
+
+	between := resultCount - int64(len(pinned))
+	if between < 0 {
+		return nil, fmt.Errorf("the result_count value (%d) needs to be at least the number of pinned elements (%d)", resultCount, len(pinned))
+	}
+
+	rest := make([]int, 0, len(input)-len(pinned))
+	for i := range input {
+		if _, ok := pinned[i]; !ok {
+			rest = append(rest, i)
+		}
+	}
+
+	if between > 0 && len(rest) == 0 {
+		return nil, fmt.Errorf("there are no elements in input besides the pinned ones to fill the remaining %d positions", between)
+	}
+
+	order := make([]int, 0, resultCount)
+	order = append(order, first...)
+	if between > 0 {
+		for _, i := range shuffleOrder(rand, len(rest), between, types.List{Null: true}) {
+			order = append(order, rest[i])
+		}
+	}
+
+	return append(order, last...), nil
+}
+
+// shuffleGroups returns the indices of the elements of each group in groupBy, with the groups in a random order.
+// Groups are collected in order of first appearance before being shuffled, so that the same seed always produces
+// the same order.
+func shuffleGroups(rand *rand.Rand, groupBy types.List) [][]int {
+	var groups [][]int
+	index := make(map[string]int)
+
+	for i, v := range groupBy.Elems {
+		name := v.(types.String).Value
+
+		g, ok := index[name]
+		if !ok {
+			g = len(groups)
+			index[name] = g
+			groups = append(groups, nil)
+		}
+
+		groups[g] = append(groups[g], i)
+	}
+
+	shuffled := make([][]int, 0, len(groups))
+	for _, g := range rand.Perm(len(groups)) {
+		shuffled = append(shuffled, groups[g])
+	}
+
+	return shuffled
+}
+
+// shuffleRequiresReplace returns a plan modifier that is identical to tfsdk.RequiresReplace(), except that it does not
+// require replacement when input is being set for the first time after an import, so that the remaining arguments
+// can be taken from the configuration at the same time.
+func shuffleRequiresReplace() tfsdk.AttributePlanModifier {
+	return shuffleRequiresReplaceModifier{}
+}
+
+type shuffleRequiresReplaceModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m shuffleRequiresReplaceModifier) Description(ctx context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless the " +
+		"resource was imported and input is being set for the first time."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m shuffleRequiresReplaceModifier) MarkdownDescription(ctx context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless the " +
+		"resource was imported and `input` is being set for the first time."
+}
+
+// Modify defers to tfsdk.RequiresReplace() unless the prior state has a null input, which only occurs following an
+// import.
+func (m shuffleRequiresReplaceModifier) Modify(ctx context.Context, req tfsdk.ModifyAttributePlanRequest, resp *tfsdk.ModifyAttributePlanResponse) {
+	if !req.State.Raw.IsNull() {
+		var input types.List
+
+		diags := req.State.GetAttribute(ctx, path.Root("input"), &input)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || input.Null {
+			return
+		}
+	}
+
+	tfsdk.RequiresReplace().Modify(ctx, req, resp)
+}
+
+// shuffleInputRequiresReplace requires replacement for any change to input other than it being set for the first
+// time after an import.
+func shuffleInputRequiresReplace(_ context.Context, state, _ attr.Value, _ path.Path) (bool, diag.Diagnostics) {
+	return !state.IsNull(), nil
+}
+
 type shuffleModelV0 struct {
 	ID          types.String `tfsdk:"id"`
 	Keepers     types.Map    `tfsdk:"keepers"`
 	Seed        types.String `tfsdk:"seed"`
+	Salt        types.String `tfsdk:"salt"`
+	Crypto      types.Bool   `tfsdk:"crypto"`
 	Input       types.List   `tfsdk:"input"`
+	GroupBy     types.List   `tfsdk:"group_by"`
 	ResultCount types.Int64  `tfsdk:"result_count"`
+	PinFirst    types.String `tfsdk:"pin_first"`
+	PinLast     types.String `tfsdk:"pin_last"`
+	HeadSize    types.Int64  `tfsdk:"head_size"`
 	Result      types.List   `tfsdk:"result"`
+	Head        types.List   `tfsdk:"head"`
+	Tail        types.List   `tfsdk:"tail"`
 }
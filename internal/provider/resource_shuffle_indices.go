@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// shuffleIndicesMaxLength is the maximum value of length, which bounds the size of the permutation generated during
+// apply.
+const shuffleIndicesMaxLength = 100000
+
+var _ tfsdk.ResourceType = (*shuffleIndicesResourceType)(nil)
+
+type shuffleIndicesResourceType struct{}
+
+func (r *shuffleIndicesResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_shuffle_indices` generates a random permutation of the indices " +
+			"`0` to `length - 1`. Unlike `random_shuffle`, which only accepts a list of strings, the " +
+			"permutation can be used to reorder a collection of any type, e.g. " +
+			"`[for i in random_shuffle_indices.example.result : var.servers[i]]`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"length": {
+				Description: "The number of indices to shuffle, typically the length of the collection being " +
+					fmt.Sprintf("reordered. Must be between `0` and `%d`.", shuffleIndicesMaxLength),
+				Type:     types.Int64Type,
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(0, shuffleIndicesMaxLength),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile permutations.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same permutation " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "Random permutation of the indices `0` to `length - 1`, in which every index " +
+					"appears exactly once.",
+				Type: types.ListType{
+					ElemType: types.Int64Type,
+				},
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *shuffleIndicesResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &shuffleIndicesResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*shuffleIndicesResource)(nil)
+
+type shuffleIndicesResource struct {
+	source *randSource
+}
+
+func (r *shuffleIndicesResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan shuffleIndicesModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
+	perm := rand.Perm(int(plan.Length.Value))
+
+	result := make([]attr.Value, 0, len(perm))
+	for _, i := range perm {
+		result = append(result, types.Int64{Value: int64(i)})
+	}
+
+	s := shuffleIndicesModelV0{
+		ID:      staticID(),
+		Keepers: plan.Keepers,
+		Length:  plan.Length,
+		Seed:    plan.Seed,
+		Salt:    plan.Salt,
+		Result: types.List{
+			Elems:    result,
+			ElemType: types.Int64Type,
+		},
+	}
+
+	diags = resp.State.Set(ctx, s)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *shuffleIndicesResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *shuffleIndicesResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *shuffleIndicesResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+type shuffleIndicesModelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Keepers types.Map    `tfsdk:"keepers"`
+	Length  types.Int64  `tfsdk:"length"`
+	Seed    types.String `tfsdk:"seed"`
+	Salt    types.String `tfsdk:"salt"`
+	Result  types.List   `tfsdk:"result"`
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceShuffleIndices(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle_indices" "indices" {
+							length = 10
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_shuffle_indices.indices", "result.#", "10"),
+					testAccResourceShuffleIndicesCheckPermutation("random_shuffle_indices.indices", 10),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffleIndices_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle_indices" "indices" {
+							length = 5
+							seed   = "12345"
+						}
+
+						locals {
+							input = [
+								{ name = "a" },
+								{ name = "b" },
+								{ name = "c" },
+								{ name = "d" },
+								{ name = "e" },
+							]
+						}
+
+						output "shuffled" {
+							value = join(",", [for i in random_shuffle_indices.indices.result : local.input[i].name])
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_shuffle_indices.indices", "result.0", "3"),
+					resource.TestCheckOutput("shuffled", "d,e,b,a,c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffleIndices_Empty(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle_indices" "indices" {
+							length = 0
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_shuffle_indices.indices", "result.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffleIndices_LengthErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle_indices" "indices" {
+							length = 100001
+						}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*Value must be between 0 and 100000, got: 100001`),
+			},
+		},
+	})
+}
+
+// testAccResourceShuffleIndicesCheckPermutation checks that result holds every index from 0 to length - 1 exactly
+// once.
+func testAccResourceShuffleIndicesCheckPermutation(name string, length int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		seen := make(map[string]bool, length)
+		for i := 0; i < length; i++ {
+			v := rs.Primary.Attributes[fmt.Sprintf("result.%d", i)]
+			if seen[v] {
+				return fmt.Errorf("index %s appears more than once", v)
+			}
+			seen[v] = true
+		}
+
+		for i := 0; i < length; i++ {
+			if !seen[fmt.Sprint(i)] {
+				return fmt.Errorf("index %d is missing", i)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.ResourceType = (*shuffleNumberResourceType)(nil)
+
+type shuffleNumberResourceType struct{}
+
+func (r *shuffleNumberResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_shuffle_number` generates a random permutation of a list of numbers " +
+			"given as an argument. It behaves like `random_shuffle`, but keeps the elements as numbers instead " +
+			"of converting them to strings.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile permutations of the list. The same seed produces the same " +
+					"permutation as `random_shuffle` does for a list of the same length.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same permutation " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"input": {
+				Description: "The list of numbers to shuffle. Elements must not be null.",
+				Type: types.ListType{
+					ElemType: types.NumberType,
+				},
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"result_count": {
+				Description: "The number of results to return. Defaults to the number of items in the " +
+					"`input` list. If fewer items are requested, some elements will be excluded from the " +
+					"result. If more items are requested, items will be repeated in the result but not more " +
+					"frequently than the number of items in the input list.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(0),
+				},
+			},
+			"result": {
+				Description: "Random permutation of the list of numbers given in `input`.",
+				Type: types.ListType{
+					ElemType: types.NumberType,
+				},
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *shuffleNumberResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &shuffleNumberResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*shuffleNumberResource)(nil)
+
+type shuffleNumberResource struct {
+	source *randSource
+}
+
+func (r *shuffleNumberResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan shuffleNumberModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := plan.Input.Elems
+
+	for i, v := range input {
+		if v.IsNull() {
+			resp.Diagnostics.AddError(
+				"Create Random Shuffle Number Error",
+				fmt.Sprintf("The input list needs to contain numbers only, got null at index %d.", i),
+			)
+			return
+		}
+	}
+
+	resultCount := plan.ResultCount.Value
+	if resultCount == 0 {
+		resultCount = int64(len(input))
+	}
+
+	result := make([]attr.Value, 0, resultCount)
+	for _, i := range shuffleOrder(r.source.newRand(plan.Seed.Value, plan.Salt.Value), len(input), resultCount, types.List{Null: true}) {
+		result = append(result, input[i])
+	}
+
+	s := shuffleNumberModelV0{
+		ID:          staticID(),
+		Keepers:     plan.Keepers,
+		Seed:        plan.Seed,
+		Salt:        plan.Salt,
+		Input:       plan.Input,
+		ResultCount: plan.ResultCount,
+		Result: types.List{
+			Elems:    result,
+			ElemType: types.NumberType,
+		},
+	}
+
+	diags = resp.State.Set(ctx, s)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *shuffleNumberResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *shuffleNumberResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *shuffleNumberResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+type shuffleNumberModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	Keepers     types.Map    `tfsdk:"keepers"`
+	Seed        types.String `tfsdk:"seed"`
+	Salt        types.String `tfsdk:"salt"`
+	Input       types.List   `tfsdk:"input"`
+	ResultCount types.Int64  `tfsdk:"result_count"`
+	Result      types.List   `tfsdk:"result"`
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceShuffleNumber(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// The seed produces the same permutation as the random_shuffle tests.
+				Config: `resource "random_shuffle_number" "default_length" {
+							input = [1, 2.5, 3, 4, 5]
+							seed  = "-"
+						}
+
+						output "sum" {
+							value = random_shuffle_number.default_length.result[0] + random_shuffle_number.default_length.result[1]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_shuffle_number.default_length", "result.#", "5"),
+					resource.TestCheckResourceAttr("random_shuffle_number.default_length", "result.0", "4"),
+					resource.TestCheckResourceAttr("random_shuffle_number.default_length", "result.1", "2.5"),
+					resource.TestCheckResourceAttr("random_shuffle_number.default_length", "result.2", "3"),
+					resource.TestCheckResourceAttr("random_shuffle_number.default_length", "result.3", "5"),
+					resource.TestCheckResourceAttr("random_shuffle_number.default_length", "result.4", "1"),
+					resource.TestCheckOutput("sum", "6.5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffleNumber_ResultCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle_number" "shorter_length" {
+							input        = [1, 2, 3, 4, 5]
+							seed         = "-"
+							result_count = 3
+						}
+						resource "random_shuffle_number" "longer_length" {
+							input        = [1, 2, 3]
+							result_count = 12
+						}
+						resource "random_shuffle_number" "empty_length" {
+							input = []
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_shuffle_number.shorter_length", "result.#", "3"),
+					resource.TestCheckResourceAttr("random_shuffle_number.shorter_length", "result.0", "4"),
+					resource.TestCheckResourceAttr("random_shuffle_number.shorter_length", "result.1", "2"),
+					resource.TestCheckResourceAttr("random_shuffle_number.shorter_length", "result.2", "3"),
+					resource.TestCheckResourceAttr("random_shuffle_number.longer_length", "result.#", "12"),
+					resource.TestCheckResourceAttr("random_shuffle_number.empty_length", "result.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffleNumber_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle_number" "numbers" {
+							input = [1, null, 3]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The input list needs to contain numbers only, got null at index 1.`),
+			},
+			{
+				Config: `resource "random_shuffle_number" "numbers" {
+							input        = [1, 2, 3]
+							result_count = -1
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 0, got: -1`),
+			},
+		},
+	})
+}
@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // These results are current as of Go 1.6. The Go
@@ -27,11 +32,11 @@ func TestAccResourceShuffle(t *testing.T) {
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrWith("random_shuffle.default_length", "result.#", testAccResourceShuffleCheckLength("5")),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.0", "a"),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.1", "c"),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.2", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.0", "d"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.1", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.2", "c"),
 					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.3", "e"),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.4", "d"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.4", "a"),
 				),
 			},
 		},
@@ -50,9 +55,9 @@ func TestAccResourceShuffle_Shorter(t *testing.T) {
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrWith("random_shuffle.shorter_length", "result.#", testAccResourceShuffleCheckLength("3")),
-					resource.TestCheckResourceAttr("random_shuffle.shorter_length", "result.0", "a"),
-					resource.TestCheckResourceAttr("random_shuffle.shorter_length", "result.1", "c"),
-					resource.TestCheckResourceAttr("random_shuffle.shorter_length", "result.2", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.shorter_length", "result.0", "d"),
+					resource.TestCheckResourceAttr("random_shuffle.shorter_length", "result.1", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.shorter_length", "result.2", "c"),
 				),
 			},
 		},
@@ -71,18 +76,18 @@ func TestAccResourceShuffle_Longer(t *testing.T) {
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrWith("random_shuffle.longer_length", "result.#", testAccResourceShuffleCheckLength("12")),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.0", "a"),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.1", "c"),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.2", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.0", "d"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.1", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.2", "c"),
 					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.3", "e"),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.4", "d"),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.5", "a"),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.6", "e"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.4", "a"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.5", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.6", "a"),
 					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.7", "d"),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.8", "c"),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.9", "b"),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.10", "a"),
-					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.11", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.8", "e"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.9", "c"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.10", "e"),
+					resource.TestCheckResourceAttr("random_shuffle.longer_length", "result.11", "d"),
 				),
 			},
 		},
@@ -126,6 +131,419 @@ func TestAccResourceShuffle_One(t *testing.T) {
 	})
 }
 
+func TestAccResourceShuffle_GroupBy(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "grouped" {
+    						input    = ["a1", "a2", "b1", "b2", "b3", "c1"]
+    						group_by = ["a", "a", "b", "b", "b", "c"]
+    						seed     = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_shuffle.grouped", "result.#", testAccResourceShuffleCheckLength("6")),
+					resource.TestCheckResourceAttr("random_shuffle.grouped", "result.0", "a1"),
+					resource.TestCheckResourceAttr("random_shuffle.grouped", "result.1", "a2"),
+					resource.TestCheckResourceAttr("random_shuffle.grouped", "result.2", "c1"),
+					resource.TestCheckResourceAttr("random_shuffle.grouped", "result.3", "b2"),
+					resource.TestCheckResourceAttr("random_shuffle.grouped", "result.4", "b3"),
+					resource.TestCheckResourceAttr("random_shuffle.grouped", "result.5", "b1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_GroupByErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "grouped" {
+    						input    = ["a1", "a2", "b1"]
+    						group_by = ["a", "a"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The group_by list needs to have the same number of elements as input \(3\),\s+got\s+2.`),
+			},
+			{
+				Config: `resource "random_shuffle" "grouped" {
+    						input        = ["a1", "a2", "b1"]
+    						group_by     = ["a", "a", "b"]
+    						result_count = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "result_count" cannot be specified when "group_by" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_HeadSize(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "partial" {
+    						input     = ["a1", "a2", "b1", "b2", "b3", "c1"]
+    						group_by  = ["a", "a", "b", "b", "b", "c"]
+    						seed      = "12345"
+    						head_size = 2
+						}
+						resource "random_shuffle" "none" {
+    						input     = ["a", "b", "c"]
+    						head_size = 0
+						}
+						resource "random_shuffle" "all" {
+    						input     = ["a", "b", "c"]
+    						head_size = 3
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_shuffle.partial", "head.#", "2"),
+					resource.TestCheckResourceAttr("random_shuffle.partial", "head.0", "a1"),
+					resource.TestCheckResourceAttr("random_shuffle.partial", "head.1", "a2"),
+					resource.TestCheckResourceAttr("random_shuffle.partial", "tail.#", "4"),
+					resource.TestCheckResourceAttr("random_shuffle.partial", "tail.0", "c1"),
+					resource.TestCheckResourceAttr("random_shuffle.partial", "tail.3", "b1"),
+					resource.TestCheckResourceAttr("random_shuffle.none", "head.#", "0"),
+					resource.TestCheckResourceAttr("random_shuffle.none", "tail.#", "3"),
+					resource.TestCheckResourceAttrPair("random_shuffle.none", "tail.0", "random_shuffle.none", "result.0"),
+					resource.TestCheckResourceAttr("random_shuffle.all", "head.#", "3"),
+					resource.TestCheckResourceAttrPair("random_shuffle.all", "head.2", "random_shuffle.all", "result.2"),
+					resource.TestCheckResourceAttr("random_shuffle.all", "tail.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_HeadSizeErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "shuffle" {
+    						input        = ["a", "b", "c"]
+    						result_count = 2
+    						head_size    = 3
+						}`,
+				ExpectError: regexp.MustCompile(`.*The head_size value \(3\) needs to be at most the number of elements in the\nresult \(2\).`),
+			},
+			{
+				Config: `resource "random_shuffle" "shuffle" {
+    						input     = ["a", "b", "c"]
+    						head_size = -1
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 0, got: -1`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Pin(t *testing.T) {
+	checks := make([]resource.TestCheckFunc, 0, 60)
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("random_shuffle.pinned.%d", i)
+		checks = append(checks,
+			resource.TestCheckResourceAttr(name, "result.#", "5"),
+			resource.TestCheckResourceAttr(name, "result.0", "default"),
+			resource.TestCheckResourceAttr(name, "result.4", "last"),
+		)
+	}
+
+	checks = append(checks,
+		resource.TestCheckResourceAttr("random_shuffle.seeded", "result.0", "a"),
+		resource.TestCheckResourceAttr("random_shuffle.seeded", "result.1", "e"),
+		resource.TestCheckResourceAttr("random_shuffle.seeded", "result.2", "d"),
+		resource.TestCheckResourceAttr("random_shuffle.twice", "result.#", "3"),
+		resource.TestCheckResourceAttr("random_shuffle.twice", "result.0", "a"),
+		resource.TestCheckResourceAttr("random_shuffle.twice", "result.1", "b"),
+		resource.TestCheckResourceAttr("random_shuffle.twice", "result.2", "a"),
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "pinned" {
+    						count     = 20
+    						input     = ["x", "last", "y", "default", "z"]
+    						pin_first = "default"
+    						pin_last  = "last"
+						}
+						resource "random_shuffle" "seeded" {
+    						input     = ["b", "c", "a", "d", "e"]
+    						pin_first = "a"
+    						seed      = "12345"
+						}
+						resource "random_shuffle" "twice" {
+    						input     = ["a", "b", "a"]
+    						pin_first = "a"
+    						pin_last  = "a"
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_PinErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "shuffle" {
+    						input     = ["a", "b", "c"]
+    						pin_first = "d"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Unable to pin elements of the result: the pin_first value "d" needs to be an\nelement of input.`),
+			},
+			{
+				Config: `resource "random_shuffle" "shuffle" {
+    						input     = ["a", "b", "c"]
+    						pin_first = "a"
+    						pin_last  = "a"
+						}`,
+				ExpectError: regexp.MustCompile(`.*pin_first and pin_last are both "a",\nwhich needs to occur at least twice in input.`),
+			},
+			{
+				Config: `resource "random_shuffle" "shuffle" {
+    						input        = ["a", "b", "c"]
+    						result_count = 1
+    						pin_first    = "a"
+    						pin_last     = "b"
+						}`,
+				ExpectError: regexp.MustCompile(`.*the result_count value \(1\) needs to be\nat least the number of pinned elements \(2\).`),
+			},
+			{
+				Config: `resource "random_shuffle" "shuffle" {
+    						input     = ["a", "b"]
+    						group_by  = ["x", "y"]
+    						pin_first = "a"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "group_by" cannot be specified when "pin_first" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Crypto(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "shuffle" {
+    						input  = ["a", "b", "c", "d", "e"]
+    						crypto = true
+						}
+						resource "random_shuffle" "pinned" {
+    						input     = ["a", "b", "c", "d", "e"]
+    						pin_first = "c"
+    						crypto    = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_shuffle.shuffle", "crypto", "true"),
+					testAccResourceShuffleCheckPermutation("random_shuffle.shuffle", []string{"a", "b", "c", "d", "e"}),
+					testAccResourceShuffleCheckPermutation("random_shuffle.pinned", []string{"a", "b", "c", "d", "e"}),
+					resource.TestCheckResourceAttr("random_shuffle.pinned", "result.0", "c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_CryptoErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "shuffle" {
+    						input  = ["a", "b", "c"]
+    						seed   = "-"
+    						crypto = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "seed" cannot be specified when "crypto" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_ImportState(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "imported" {
+							input = ["a", "b", "c", "d", "e"]
+						}`,
+			},
+			{
+				ResourceName:  "random_shuffle.imported",
+				ImportState:   true,
+				ImportStateId: "e,c,a,d,b",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+
+					expected := []string{"e", "c", "a", "d", "b"}
+					attrs := states[0].Attributes
+
+					if attrs["result.#"] != fmt.Sprint(len(expected)) {
+						return fmt.Errorf("expected result.# to be %d, got %s", len(expected), attrs["result.#"])
+					}
+
+					for i, v := range expected {
+						if actual := attrs[fmt.Sprintf("result.%d", i)]; actual != v {
+							return fmt.Errorf("expected result.%d to be %q, got %q", i, v, actual)
+						}
+					}
+
+					if _, ok := attrs["seed"]; ok {
+						return fmt.Errorf("expected seed to be absent, got %q", attrs["seed"])
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// TestShuffleResource_ImportStateThenSeed plans and applies, against the provider server, the first configuration
+// of an imported random_shuffle, as the acceptance test framework does not keep the state of import steps.
+func TestShuffleResource_ImportStateThenSeed(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := protoV6ProviderFactories()["random"]()
+	if err != nil {
+		t.Fatalf("error creating server: %s", err)
+	}
+
+	schema, diags := (&shuffleResourceType{}).GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("error getting schema: %v", diags)
+	}
+	typ := schema.TerraformType(ctx)
+
+	imported, err := server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: "random_shuffle",
+		ID:       "e,c,a,d,b",
+	})
+	if err != nil {
+		t.Fatalf("error importing: %s", err)
+	}
+	testShuffleCheckDiagnostics(t, imported.Diagnostics)
+
+	priorState := imported.ImportedResources[0].State
+	prior := testShuffleValues(t, typ, priorState)
+
+	config := make(map[string]tftypes.Value, len(schema.Attributes))
+	for k, a := range schema.Attributes {
+		config[k] = tftypes.NewValue(a.Type.TerraformType(ctx), nil)
+	}
+	config["input"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "a"),
+		tftypes.NewValue(tftypes.String, "b"),
+		tftypes.NewValue(tftypes.String, "c"),
+		tftypes.NewValue(tftypes.String, "d"),
+		tftypes.NewValue(tftypes.String, "e"),
+	})
+	config["seed"] = tftypes.NewValue(tftypes.String, "12345")
+	config["head_size"] = tftypes.NewValue(tftypes.Number, 2)
+
+	proposed := make(map[string]tftypes.Value, len(config))
+	for k, v := range config {
+		proposed[k] = v
+	}
+	for _, k := range []string{"id", "result", "head", "tail"} {
+		proposed[k] = prior[k]
+	}
+
+	configValue := testShuffleDynamicValue(t, typ, config)
+
+	planned, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "random_shuffle",
+		PriorState:       priorState,
+		ProposedNewState: testShuffleDynamicValue(t, typ, proposed),
+		Config:           configValue,
+	})
+	if err != nil {
+		t.Fatalf("error planning: %s", err)
+	}
+	testShuffleCheckDiagnostics(t, planned.Diagnostics)
+
+	if len(planned.RequiresReplace) != 0 {
+		t.Errorf("expected no replacement, got %v", planned.RequiresReplace)
+	}
+
+	applied, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "random_shuffle",
+		PriorState:   priorState,
+		PlannedState: planned.PlannedState,
+		Config:       configValue,
+	})
+	if err != nil {
+		t.Fatalf("error applying: %s", err)
+	}
+	testShuffleCheckDiagnostics(t, applied.Diagnostics)
+
+	state := testShuffleValues(t, typ, applied.NewState)
+
+	if !state["result"].Equal(prior["result"]) {
+		t.Errorf("expected result to be kept as %s, got %s", prior["result"], state["result"])
+	}
+
+	for k, expected := range map[string]tftypes.Value{
+		"seed":      config["seed"],
+		"head_size": config["head_size"],
+		"head": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "e"),
+			tftypes.NewValue(tftypes.String, "c"),
+		}),
+	} {
+		if !state[k].Equal(expected) {
+			t.Errorf("expected %s to be %s, got %s", k, expected, state[k])
+		}
+	}
+}
+
+func testShuffleCheckDiagnostics(t *testing.T, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+func testShuffleDynamicValue(t *testing.T, typ tftypes.Type, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	v, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, values))
+	if err != nil {
+		t.Fatalf("error creating dynamic value: %s", err)
+	}
+
+	return &v
+}
+
+func testShuffleValues(t *testing.T, typ tftypes.Type, dv *tfprotov6.DynamicValue) map[string]tftypes.Value {
+	t.Helper()
+
+	v, err := dv.Unmarshal(typ)
+	if err != nil {
+		t.Fatalf("error unmarshalling state: %s", err)
+	}
+
+	var values map[string]tftypes.Value
+	if err := v.As(&values); err != nil {
+		t.Fatalf("error converting state: %s", err)
+	}
+
+	return values
+}
+
 func TestAccResourceShuffle_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
@@ -137,11 +555,11 @@ func TestAccResourceShuffle_UpgradeFromVersion3_3_2(t *testing.T) {
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrWith("random_shuffle.default_length", "result.#", testAccResourceShuffleCheckLength("5")),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.0", "a"),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.1", "c"),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.2", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.0", "d"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.1", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.2", "c"),
 					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.3", "e"),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.4", "d"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.4", "a"),
 				),
 			},
 			{
@@ -160,11 +578,11 @@ func TestAccResourceShuffle_UpgradeFromVersion3_3_2(t *testing.T) {
 						}`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrWith("random_shuffle.default_length", "result.#", testAccResourceShuffleCheckLength("5")),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.0", "a"),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.1", "c"),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.2", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.0", "d"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.1", "b"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.2", "c"),
 					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.3", "e"),
-					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.4", "d"),
+					resource.TestCheckResourceAttr("random_shuffle.default_length", "result.4", "a"),
 				),
 			},
 		},
@@ -180,3 +598,32 @@ func testAccResourceShuffleCheckLength(expectedLength string) func(input string)
 		return nil
 	}
 }
+
+// testAccResourceShuffleCheckPermutation checks that result holds every element of input exactly once.
+func testAccResourceShuffleCheckPermutation(name string, input []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if got := rs.Primary.Attributes["result.#"]; got != fmt.Sprint(len(input)) {
+			return fmt.Errorf("got length %s; expected length %d", got, len(input))
+		}
+
+		remaining := make(map[string]int, len(input))
+		for _, v := range input {
+			remaining[v]++
+		}
+
+		for i := range input {
+			v := rs.Primary.Attributes[fmt.Sprintf("result.%d", i)]
+			if remaining[v] == 0 {
+				return fmt.Errorf("element %q is not in input or appears too often", v)
+			}
+			remaining[v]--
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+const slugChars = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// slugPattern matches lowercase alphanumeric words joined by single hyphens.
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+var _ tfsdk.ResourceType = (*slugResourceType)(nil)
+
+type slugResourceType struct{}
+
+func (r *slugResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_slug` generates URL-safe identifiers made up of lowercase letters, " +
+			"digits and hyphens, such as `k3x-9fq2` or `wholly-sharp-lemur`. A slug never starts or ends with " +
+			"a hyphen, and never contains two hyphens in a row.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator and should not be used " +
+			"for secrets.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"length": {
+				Description: "The number of characters in the slug, drawn from lowercase letters, digits and " +
+					"hyphens. Exactly one of `length` or `word_count` must be set.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+					schemavalidator.ExactlyOneOf(path.MatchRoot("word_count")),
+				},
+			},
+			"word_count": {
+				Description: "The number of words in the slug, drawn from the word lists used by " +
+					"`random_pet` and joined by hyphens. Exactly one of `length` or `word_count` must be set.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile slugs.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same slug " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The generated slug.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *slugResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &slugResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*slugResource)(nil)
+
+type slugResource struct {
+	source *randSource
+}
+
+func (r *slugResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan slugModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rand := r.source.newRand(plan.Seed.Value, plan.Salt.Value)
+
+	var result string
+
+	if plan.WordCount.Null {
+		slug := make([]byte, plan.Length.Value)
+
+		for i := range slug {
+			// Hyphens are only allowed between two other characters, and never next to another hyphen.
+			chars := slugChars + "-"
+			if i == 0 || i == len(slug)-1 || slug[i-1] == '-' {
+				chars = slugChars
+			}
+
+			slug[i] = chars[rand.Intn(len(chars))]
+		}
+
+		result = string(slug)
+	} else {
+		result = random.CreatePetName(rand, random.DefaultPetWords(), plan.WordCount.Value, "-")
+	}
+
+	if !slugPattern.MatchString(result) {
+		resp.Diagnostics.AddError(
+			"Create Random Slug Error",
+			fmt.Sprintf("The generated value %q is not a valid slug. This is always an error in the provider.", result),
+		)
+		return
+	}
+
+	s := slugModelV0{
+		ID:        staticID(),
+		Keepers:   plan.Keepers,
+		Length:    plan.Length,
+		WordCount: plan.WordCount,
+		Seed:      plan.Seed,
+		Salt:      plan.Salt,
+		Result:    types.String{Value: result},
+	}
+
+	diags = resp.State.Set(ctx, s)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *slugResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *slugResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *slugResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+type slugModelV0 struct {
+	ID        types.String `tfsdk:"id"`
+	Keepers   types.Map    `tfsdk:"keepers"`
+	Length    types.Int64  `tfsdk:"length"`
+	WordCount types.Int64  `tfsdk:"word_count"`
+	Seed      types.String `tfsdk:"seed"`
+	Salt      types.String `tfsdk:"salt"`
+	Result    types.String `tfsdk:"result"`
+}
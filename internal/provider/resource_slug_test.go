@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSlug(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_slug" "slug" {
+							length = 64
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_slug.slug", "result", testCheckLen(64)),
+					resource.TestMatchResourceAttr("random_slug.slug", "result", regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSlug_WordCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_slug" "slug" {
+							word_count = 3
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_slug.slug", "result", testCheckPetLen("-", 3)),
+					resource.TestMatchResourceAttr("random_slug.slug", "result", regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSlug_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_slug" "length" {
+							length = 12
+							seed   = "12345"
+						}
+						resource "random_slug" "word_count" {
+							word_count = 2
+							seed       = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_slug.length", "result", "lwq7eiwubjhv"),
+					resource.TestCheckResourceAttr("random_slug.word_count", "result", "together-bullfrog"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSlug_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_slug" "slug" {
+							length     = 8
+							word_count = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*2 attributes specified when one \(and only one\) of`),
+			},
+			{
+				Config: `resource "random_slug" "slug" {
+							seed = "12345"
+						}`,
+				ExpectError: regexp.MustCompile(`.*No attribute specified when one \(and only one\) of`),
+			},
+			{
+				Config: `resource "random_slug" "slug" {
+							length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
@@ -2,8 +2,20 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -14,6 +26,13 @@ import (
 	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
+// stringMaxResultCount is the maximum value of result_count, which bounds the number of strings generated during
+// apply.
+const stringMaxResultCount = 10000
+
+// stringMaxTokenCount is the maximum value of token_count, which bounds the number of tokens drawn into each string.
+const stringMaxTokenCount = 1024
+
 var _ tfsdk.ResourceType = (*stringResourceType)(nil)
 
 type stringResourceType struct{}
@@ -42,11 +61,15 @@ func (r stringResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagn
 				},
 			},
 
+			"regenerate_on": regenerateOnAttribute(),
+			"nonce":         nonceAttribute(),
+
 			"length": {
 				Description: "The length of the string desired. The minimum value for length is 1 and, length " +
-					"must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special`).",
+					"must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special`). Required " +
+					"unless `tokens` is set, in which case it cannot be set.",
 				Type:          types.Int64Type,
-				Required:      true,
+				Optional:      true,
 				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
 				Validators: []tfsdk.AttributeValidator{
 					int64validator.AtLeast(1),
@@ -59,6 +82,52 @@ func (r stringResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagn
 				},
 			},
 
+			"tokens": {
+				Description: "Generate the string from `token_count` tokens drawn with replacement from this " +
+					"list and joined by `token_separator`, e.g. syllables for readable identifiers, instead of " +
+					"from `length` characters. The list must not be empty, and elements must not be null. " +
+					"`length`, the character class arguments, `override_special`, `charset_spec`, " +
+					"`char_weights`, `must_start_with_letter`, `alternate_case`, `ensure_all_classes`, " +
+					"`max_consecutive`, `exclude_dictionary` and `dictionary` cannot be set.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+
+			"token_count": {
+				Description: fmt.Sprintf("The number of tokens in the string. Must be between `1` and `%d`. ", stringMaxTokenCount) +
+					"Required when `tokens` is set.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, stringMaxTokenCount),
+					schemavalidator.AlsoRequires(path.MatchRoot("tokens")),
+				},
+			},
+
+			"token_separator": {
+				Description: "The separator between the tokens of the string. Requires `tokens`. Default value " +
+					"is the empty string.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.AlsoRequires(path.MatchRoot("tokens")),
+				},
+			},
+
 			"special": {
 				Description: "Include special characters in the result. These are `!@#$%&*()-_=+[]{}<>:?`. Default value is `true`.",
 				Type:        types.BoolType,
@@ -159,12 +228,226 @@ func (r stringResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagn
 				},
 			},
 
+			"charset_spec": {
+				Description: "Explicit set of characters to generate the result from, given as POSIX-like " +
+					"class names such as `[:alnum:]`, ranges such as `a-z0-9`, individual characters, or any " +
+					"combination of these. Supported classes are `alnum`, `alpha`, `digit`, `lower`, `punct`, " +
+					"`upper` and `xdigit`. When set, this takes precedence over `upper`, `lower`, `numeric`, " +
+					"`special` and `override_special`, and cannot be combined with any of the `min_*` arguments " +
+					"or `must_start_with_letter`.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"char_weights": {
+				Description: "Map of single characters to their relative weight. When set, every character " +
+					"of the result is drawn from the keys of this map, with a probability proportional to its " +
+					"weight, instead of uniformly from the enabled character classes. Weights must not be " +
+					"negative and must add up to more than zero. Like `charset_spec`, this takes precedence " +
+					"over `upper`, `lower`, `numeric`, `special` and `override_special`, and cannot be " +
+					"combined with any of the `min_*` arguments or `must_start_with_letter`.",
+				Type: types.MapType{
+					ElemType: types.Int64Type,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					mapvalidator.ValuesAre(int64validator.AtLeast(0)),
+					schemavalidator.ConflictsWith(path.MatchRoot("charset_spec")),
+				},
+			},
+
+			"must_start_with_letter": {
+				Description: "Guarantee that the first character of the result is a letter. At least one of " +
+					"`upper` or `lower` must be enabled. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"alternate_case": {
+				Description: "Give every letter of the result a random case after it is generated, " +
+					"independently of the character classes. Letters are drawn from a single alphabet when " +
+					"either `upper` or `lower` is enabled. Cannot be combined with `min_upper` or `min_lower`. " +
+					"Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"ensure_all_classes": {
+				Description: "Guarantee that the result contains at least one character of every enabled " +
+					"character class, by raising the minimum of each class out of `upper`, `lower`, `numeric` " +
+					"and `special` that is enabled to at least 1. Higher minimums set by the `min_*` arguments " +
+					"are kept. The `length` must be large enough for the raised minimums. Cannot be combined " +
+					"with `charset_spec`, `char_weights` or `alternate_case`. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.ConflictsWith(
+						path.MatchRoot("charset_spec"),
+						path.MatchRoot("char_weights"),
+						path.MatchRoot("alternate_case"),
+					),
+				},
+			},
+
+			"max_consecutive": {
+				Description: "The maximum number of consecutive occurrences of the same character in the " +
+					"result, e.g. `2` rejects `aaa`. Each character that would exceed it is re-drawn from the " +
+					"other enabled characters. The minimum value is 1.",
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"exclude": {
+				Description: "List of values that the result must not be equal to, such as codes that " +
+					"have already been issued. The result is re-drawn until it is not in the list, giving up " +
+					fmt.Sprintf("after %d attempts. ", random.MaxAttempts) +
+					"**Note:** When the list covers a large share of the possible results for the given " +
+					"`length` and character set, generation is likely to fail.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"exclude_file": {
+				Description: "Path to a file of newline-delimited values that the result must not be equal " +
+					"to, in addition to those in `exclude`. Blank lines are ignored, and a file that does not " +
+					"exist is treated as empty. The file is read from the local filesystem of the machine " +
+					"running Terraform when the resource is created; changing its contents does not " +
+					"trigger recreation of the resource, only changing the path does.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"exclude_dictionary": {
+				Description: "Re-draw the result while it contains a word from `dictionary` as a substring, " +
+					"ignoring case, giving up " + fmt.Sprintf("after %d attempts. ", random.MaxAttempts) +
+					"Unless `dictionary` is set, a built-in list of " +
+					fmt.Sprintf("%d common English words and password fragments of at least four letters is used, ", len(random.DictionaryWords())) +
+					"e.g. `pass` or `admin`. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"dictionary": {
+				Description: "List of words that replaces the built-in list used by `exclude_dictionary`. " +
+					"Words must not be empty. **Note:** Short words are likely to appear in random strings, " +
+					"so that generation fails more often the shorter the words are. Requires " +
+					"`exclude_dictionary`.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.AlsoRequires(path.MatchRoot("exclude_dictionary")),
+					listvalidator.ValuesAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"collision_group": {
+				Description: collisionGroupDescription,
+				Type:        types.StringType,
+				Optional:    true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+
+			"result_count": {
+				Description: "The number of strings to generate into `results`. When set, `results` holds " +
+					"`result` followed by `result_count - 1` further strings generated with the same arguments. " +
+					fmt.Sprintf("Must be between `1` and `%d`.", stringMaxResultCount),
+				Type:     types.Int64Type,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, stringMaxResultCount),
+				},
+			},
+
+			"unique": {
+				Description: "Guarantee that all strings in `results` are distinct, e.g. for issuing tokens in " +
+					"bulk. Every string that equals an earlier one is re-generated, giving up after " +
+					fmt.Sprintf("%d attempts. ", random.MaxAttempts) +
+					"The number of possible strings for the given `length` and character set, or `tokens` and " +
+					"`token_count`, must be at least " +
+					"`result_count`. Requires `result_count`. Default value is `false`.",
+				Type:     types.BoolType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					schemavalidator.AlsoRequires(path.MatchRoot("result_count")),
+				},
+			},
+
 			"result": {
 				Description: "The generated random string.",
 				Type:        types.StringType,
 				Computed:    true,
 			},
 
+			"results": {
+				Description: "The `result_count` generated strings, starting with `result`. Only set when " +
+					"`result_count` is set.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+
+			"characters": {
+				Description: "The characters of `result`, in order, as a list of single-character strings.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
+
+			"result_length": {
+				Description: "The number of characters in `result`, counted as Unicode code points rather than " +
+					"bytes. Unless `tokens` is set, it equals `length`, except when `override_special` contains " +
+					"characters outside of ASCII, which are drawn byte by byte.",
+				Type:     types.Int64Type,
+				Computed: true,
+			},
+
 			"id": {
 				Description: "The generated random string.",
 				Computed:    true,
@@ -175,16 +458,90 @@ func (r stringResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagn
 }
 
 func (r stringResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
-	return &stringResource{}, nil
+	return &stringResource{
+		collisions: p.(*provider).collisions,
+	}, nil
 }
 
 var (
-	_ tfsdk.Resource                 = (*stringResource)(nil)
-	_ tfsdk.ResourceWithImportState  = (*stringResource)(nil)
-	_ tfsdk.ResourceWithUpgradeState = (*stringResource)(nil)
+	_ tfsdk.Resource                   = (*stringResource)(nil)
+	_ tfsdk.ResourceWithImportState    = (*stringResource)(nil)
+	_ tfsdk.ResourceWithUpgradeState   = (*stringResource)(nil)
+	_ tfsdk.ResourceWithValidateConfig = (*stringResource)(nil)
 )
 
-type stringResource struct{}
+type stringResource struct {
+	collisions *collisionRegistry
+}
+
+// ValidateConfig checks that length is set unless tokens is set, and that the arguments of token strings are set if
+// and only if tokens is set, as this cannot be expressed with attribute validators alone.
+func (r *stringResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config stringModelV2
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Tokens.Unknown {
+		return
+	}
+
+	if config.Tokens.Null {
+		if config.Length.Null {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("length"),
+				"Missing Attribute Configuration",
+				"The length argument needs to be set unless tokens is set.",
+			)
+		}
+
+		return
+	}
+
+	if config.TokenCount.Null {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_count"),
+			"Missing Attribute Configuration",
+			"The token_count argument needs to be set when tokens is set.",
+		)
+	}
+
+	// These arguments have no effect on a string of tokens.
+	for _, a := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"length", config.Length},
+		{"special", config.Special},
+		{"upper", config.Upper},
+		{"lower", config.Lower},
+		{"numeric", config.Numeric},
+		{"min_numeric", config.MinNumeric},
+		{"min_upper", config.MinUpper},
+		{"min_lower", config.MinLower},
+		{"min_special", config.MinSpecial},
+		{"override_special", config.OverrideSpecial},
+		{"charset_spec", config.CharsetSpec},
+		{"char_weights", config.CharWeights},
+		{"must_start_with_letter", config.MustStartWithLetter},
+		{"alternate_case", config.AlternateCase},
+		{"ensure_all_classes", config.EnsureAllClasses},
+		{"max_consecutive", config.MaxConsecutive},
+		{"exclude_dictionary", config.ExcludeDictionary},
+		{"dictionary", config.Dictionary},
+	} {
+		if !a.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(a.name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("The %s argument cannot be set when tokens is set.", a.name),
+			)
+		}
+	}
+}
 
 func (r *stringResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	var plan stringModelV2
@@ -195,39 +552,323 @@ func (r *stringResource) Create(ctx context.Context, req tfsdk.CreateResourceReq
 		return
 	}
 
+	var err error
+	var charset string
+	if !plan.CharsetSpec.Null {
+		charset, err = random.ParseCharsetSpec(plan.CharsetSpec.Value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				fmt.Sprintf("The charset_spec value %q is invalid: %s.", plan.CharsetSpec.Value, err),
+			)
+			return
+		}
+
+		if plan.MinUpper.Value+plan.MinLower.Value+plan.MinNumeric.Value+plan.MinSpecial.Value > 0 || plan.MustStartWithLetter.Value {
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				"The min_upper, min_lower, min_numeric, min_special and must_start_with_letter arguments "+
+					"cannot be used when charset_spec is set.",
+			)
+			return
+		}
+	}
+
+	var weights map[byte]int64
+	if !plan.CharWeights.Null {
+		var total int64
+		weights = make(map[byte]int64, len(plan.CharWeights.Elems))
+
+		for k, v := range plan.CharWeights.Elems {
+			if len(k) != 1 || k[0] < ' ' || k[0] > '~' {
+				resp.Diagnostics.AddError(
+					"Create Random String Error",
+					fmt.Sprintf("The char_weights key %q is invalid: keys must be a single printable ASCII character.", k),
+				)
+				return
+			}
+
+			weight := v.(types.Int64).Value
+			if total > math.MaxInt64-weight {
+				resp.Diagnostics.AddError(
+					"Create Random String Error",
+					"The sum of all char_weights values needs to fit within a 64-bit integer.",
+				)
+				return
+			}
+
+			total += weight
+			weights[k[0]] = weight
+		}
+
+		if total == 0 {
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				"At least one char_weights value needs to be greater than zero.",
+			)
+			return
+		}
+
+		if plan.MinUpper.Value+plan.MinLower.Value+plan.MinNumeric.Value+plan.MinSpecial.Value > 0 || plan.MustStartWithLetter.Value {
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				"The min_upper, min_lower, min_numeric, min_special and must_start_with_letter arguments "+
+					"cannot be used when char_weights is set.",
+			)
+			return
+		}
+	}
+
+	if plan.MustStartWithLetter.Value {
+		if !plan.Upper.Value && !plan.Lower.Value {
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				"At least one of upper or lower needs to be true when must_start_with_letter is true.",
+			)
+			return
+		}
+
+		if plan.Length.Value-plan.MinNumeric.Value-plan.MinSpecial.Value < 1 {
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				"The length needs to be greater than (min_numeric + min_special) when must_start_with_letter is true.",
+			)
+			return
+		}
+	}
+
+	if plan.AlternateCase.Value && plan.MinUpper.Value+plan.MinLower.Value > 0 {
+		resp.Diagnostics.AddError(
+			"Create Random String Error",
+			"The min_upper and min_lower arguments cannot be used when alternate_case is true.",
+		)
+		return
+	}
+
+	exclude := make([]string, 0, len(plan.Exclude.Elems))
+	for _, v := range plan.Exclude.Elems {
+		exclude = append(exclude, v.(types.String).Value)
+	}
+
+	if !plan.ExcludeFile.Null {
+		values, err := stringExcludeFile(plan.ExcludeFile.Value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				fmt.Sprintf("The exclude_file %q could not be read: %s.", plan.ExcludeFile.Value, err),
+			)
+			return
+		}
+
+		exclude = append(exclude, values...)
+	}
+
+	var words []string
+	if plan.ExcludeDictionary.Value {
+		words = random.DictionaryWords()
+		if !plan.Dictionary.Null {
+			words = make([]string, 0, len(plan.Dictionary.Elems))
+			for _, v := range plan.Dictionary.Elems {
+				words = append(words, v.(types.String).Value)
+			}
+		}
+	}
+
 	params := random.StringParams{
-		Length:          plan.Length.Value,
-		Upper:           plan.Upper.Value,
-		MinUpper:        plan.MinUpper.Value,
-		Lower:           plan.Lower.Value,
-		MinLower:        plan.MinLower.Value,
-		Numeric:         plan.Numeric.Value,
-		MinNumeric:      plan.MinNumeric.Value,
-		Special:         plan.Special.Value,
-		MinSpecial:      plan.MinSpecial.Value,
-		OverrideSpecial: plan.OverrideSpecial.Value,
-	}
-
-	result, err := random.CreateString(params)
+		Length:              plan.Length.Value,
+		Upper:               plan.Upper.Value,
+		MinUpper:            plan.MinUpper.Value,
+		Lower:               plan.Lower.Value,
+		MinLower:            plan.MinLower.Value,
+		Numeric:             plan.Numeric.Value,
+		MinNumeric:          plan.MinNumeric.Value,
+		Special:             plan.Special.Value,
+		MinSpecial:          plan.MinSpecial.Value,
+		OverrideSpecial:     plan.OverrideSpecial.Value,
+		Charset:             charset,
+		Weights:             weights,
+		MustStartWithLetter: plan.MustStartWithLetter.Value,
+		AlternateCase:       plan.AlternateCase.Value,
+		Exclude:             exclude,
+		ExcludeRepeated:     !plan.MaxConsecutive.Null,
+		MaxSequence:         plan.MaxConsecutive.Value,
+		ExcludeWords:        words,
+	}
+
+	if plan.EnsureAllClasses.Value {
+		stringEnsureAllClasses(&params)
+
+		if params.MinUpper+params.MinLower+params.MinNumeric+params.MinSpecial > params.Length {
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				"The length needs to be at least (min_upper + min_lower + min_numeric + min_special) when "+
+					"ensure_all_classes is true, counting a minimum of 1 for every enabled class.",
+			)
+			return
+		}
+	}
+
+	if params.ExcludeRepeated && params.Length > params.MaxSequence && stringDistinctChars(params) < 2 {
+		resp.Diagnostics.AddError(
+			"Create Random String Error",
+			"At least two different characters need to be enabled when max_consecutive is set and the length "+
+				"is greater than max_consecutive.",
+		)
+		return
+	}
+
+	if len(words) > 0 && !stringWordsAvoidable(params) {
+		resp.Diagnostics.AddError(
+			"Create Random String Error",
+			"Every enabled character forms a word of the dictionary when repeated to the given length, so "+
+				"no result can avoid the dictionary (exclude_dictionary). Remove short words from "+
+				"dictionary or enable more characters.",
+		)
+		return
+	}
+
+	generate := func() ([]byte, error) {
+		return random.CreateString(params)
+	}
+	createError := func(err error) diag.Diagnostics {
+		return stringCreateError(err, params)
+	}
+
+	if plan.Tokens.Null && plan.Unique.Value && !stringKeyspaceAtLeast(params, plan.ResultCount.Value) {
+		resp.Diagnostics.AddError(
+			"Create Random String Error",
+			fmt.Sprintf("There are fewer possible results than result_count (%d) for the given length and ", plan.ResultCount.Value)+
+				"character set, so the results cannot be unique. Reduce result_count, enable more characters or "+
+				"increase length.",
+		)
+		return
+	}
+
+	if !plan.Tokens.Null {
+		tokens := random.TokenParams{
+			Tokens:    make([]string, 0, len(plan.Tokens.Elems)),
+			Count:     plan.TokenCount.Value,
+			Separator: plan.TokenSeparator.Value,
+			Exclude:   exclude,
+		}
+
+		for i, v := range plan.Tokens.Elems {
+			if v.IsNull() {
+				resp.Diagnostics.AddError(
+					"Create Random String Error",
+					fmt.Sprintf("The tokens list needs to contain strings only, got null at index %d.", i),
+				)
+				return
+			}
+
+			tokens.Tokens = append(tokens.Tokens, v.(types.String).Value)
+		}
+
+		if plan.Unique.Value && !stringTokensKeyspaceAtLeast(tokens, plan.ResultCount.Value) {
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				fmt.Sprintf("There are fewer possible results than result_count (%d) for the given tokens and ", plan.ResultCount.Value)+
+					"token_count, so the results cannot be unique. Reduce result_count, add more tokens or "+
+					"increase token_count.",
+			)
+			return
+		}
+
+		generate = func() ([]byte, error) {
+			return random.CreateTokenString(tokens)
+		}
+		createError = stringTokensCreateError
+	}
+
+	var result []byte
+
+	for attempt := 1; ; attempt++ {
+		result, err = generate()
+		if err != nil || plan.CollisionGroup.Null || r.collisions.register(plan.CollisionGroup.Value, string(result)) {
+			break
+		}
+
+		if attempt >= random.MaxAttempts {
+			resp.Diagnostics.Append(collisionError("Create Random String Error", plan.CollisionGroup.Value)...)
+			return
+		}
+	}
+
 	if err != nil {
-		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+		resp.Diagnostics.Append(createError(err)...)
 		return
 	}
 
+	results := types.List{ElemType: types.StringType, Null: true}
+	if !plan.ResultCount.Null {
+		values := []string{string(result)}
+		seen := map[string]struct{}{string(result): {}}
+
+	Draws:
+		for int64(len(values)) < plan.ResultCount.Value {
+			for attempt := 0; attempt < random.MaxAttempts; attempt++ {
+				candidate, err := generate()
+				if err != nil {
+					resp.Diagnostics.Append(createError(err)...)
+					return
+				}
+
+				if _, ok := seen[string(candidate)]; !ok || !plan.Unique.Value {
+					values = append(values, string(candidate))
+					seen[string(candidate)] = struct{}{}
+					continue Draws
+				}
+			}
+
+			resp.Diagnostics.AddError(
+				"Create Random String Error",
+				fmt.Sprintf("Unable to generate %d distinct results (unique) within %d attempts. ", plan.ResultCount.Value, random.MaxAttempts)+
+					"Reduce result_count or increase the number of possible results, e.g. by increasing length.",
+			)
+			return
+		}
+
+		results.Null = false
+		for _, v := range values {
+			results.Elems = append(results.Elems, types.String{Value: v})
+		}
+	}
+
 	state := stringModelV2{
-		ID:              types.String{Value: string(result)},
-		Keepers:         plan.Keepers,
-		Length:          types.Int64{Value: plan.Length.Value},
-		Special:         types.Bool{Value: plan.Special.Value},
-		Upper:           types.Bool{Value: plan.Upper.Value},
-		Lower:           types.Bool{Value: plan.Lower.Value},
-		Numeric:         types.Bool{Value: plan.Numeric.Value},
-		MinNumeric:      types.Int64{Value: plan.MinNumeric.Value},
-		MinUpper:        types.Int64{Value: plan.MinUpper.Value},
-		MinLower:        types.Int64{Value: plan.MinLower.Value},
-		MinSpecial:      types.Int64{Value: plan.MinSpecial.Value},
-		OverrideSpecial: types.String{Value: plan.OverrideSpecial.Value},
-		Result:          types.String{Value: string(result)},
+		ID:                  resultID(string(result)),
+		Keepers:             plan.Keepers,
+		RegenerateOn:        plan.RegenerateOn,
+		Nonce:               plan.Nonce,
+		Length:              plan.Length,
+		Special:             types.Bool{Value: plan.Special.Value},
+		Upper:               types.Bool{Value: plan.Upper.Value},
+		Lower:               types.Bool{Value: plan.Lower.Value},
+		Numeric:             types.Bool{Value: plan.Numeric.Value},
+		MinNumeric:          types.Int64{Value: plan.MinNumeric.Value},
+		MinUpper:            types.Int64{Value: plan.MinUpper.Value},
+		MinLower:            types.Int64{Value: plan.MinLower.Value},
+		MinSpecial:          types.Int64{Value: plan.MinSpecial.Value},
+		OverrideSpecial:     types.String{Value: plan.OverrideSpecial.Value},
+		CharsetSpec:         plan.CharsetSpec,
+		CharWeights:         plan.CharWeights,
+		MustStartWithLetter: plan.MustStartWithLetter,
+		AlternateCase:       plan.AlternateCase,
+		EnsureAllClasses:    plan.EnsureAllClasses,
+		MaxConsecutive:      plan.MaxConsecutive,
+		Exclude:             plan.Exclude,
+		ExcludeFile:         plan.ExcludeFile,
+		ExcludeDictionary:   plan.ExcludeDictionary,
+		Dictionary:          plan.Dictionary,
+		CollisionGroup:      plan.CollisionGroup,
+		ResultCount:         plan.ResultCount,
+		Unique:              plan.Unique,
+		Tokens:              plan.Tokens,
+		TokenCount:          plan.TokenCount,
+		TokenSeparator:      plan.TokenSeparator,
+		Result:              types.String{Value: string(result)},
+		Results:             results,
+		Characters:          stringCharacters(string(result)),
+		ResultLength:        types.Int64{Value: int64(utf8.RuneCount(result))},
 	}
 
 	diags = resp.State.Set(ctx, state)
@@ -254,14 +895,18 @@ func (r *stringResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReq
 func (r *stringResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
 	id := req.ID
 
+	// The character classes are inferred from the imported value, so that a configuration matching the
+	// value does not trigger a replacement.
+	inferred := random.InferStringParams(id)
+
 	state := stringModelV2{
-		ID:         types.String{Value: id},
+		ID:         resultID(id),
 		Result:     types.String{Value: id},
-		Length:     types.Int64{Value: int64(len(id))},
-		Special:    types.Bool{Value: true},
-		Upper:      types.Bool{Value: true},
-		Lower:      types.Bool{Value: true},
-		Numeric:    types.Bool{Value: true},
+		Length:     types.Int64{Value: inferred.Length},
+		Special:    types.Bool{Value: inferred.Special},
+		Upper:      types.Bool{Value: inferred.Upper},
+		Lower:      types.Bool{Value: inferred.Lower},
+		Numeric:    types.Bool{Value: inferred.Numeric},
 		MinSpecial: types.Int64{Value: 0},
 		MinUpper:   types.Int64{Value: 0},
 		MinLower:   types.Int64{Value: 0},
@@ -269,6 +914,27 @@ func (r *stringResource) ImportState(ctx context.Context, req tfsdk.ImportResour
 	}
 
 	state.Keepers.ElemType = types.StringType
+	state.RegenerateOn = types.Set{ElemType: types.StringType, Null: true}
+	state.Nonce.Null = true
+	state.CharsetSpec.Null = true
+	state.CharWeights = types.Map{ElemType: types.Int64Type, Null: true}
+	state.MustStartWithLetter.Null = true
+	state.AlternateCase.Null = true
+	state.EnsureAllClasses.Null = true
+	state.MaxConsecutive.Null = true
+	state.Exclude = types.List{ElemType: types.StringType, Null: true}
+	state.ExcludeFile.Null = true
+	state.ExcludeDictionary.Null = true
+	state.Dictionary = types.List{ElemType: types.StringType, Null: true}
+	state.CollisionGroup.Null = true
+	state.ResultCount.Null = true
+	state.Unique.Null = true
+	state.Tokens = types.List{ElemType: types.StringType, Null: true}
+	state.TokenCount.Null = true
+	state.TokenSeparator.Null = true
+	state.Results = types.List{ElemType: types.StringType, Null: true}
+	state.Characters = stringCharacters(id)
+	state.ResultLength = types.Int64{Value: int64(utf8.RuneCountInString(id))}
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -479,22 +1145,268 @@ func upgradeStringStateV1toV2(ctx context.Context, req tfsdk.UpgradeResourceStat
 		ID:              stringDataV1.ID,
 	}
 
+	stringDataV2.RegenerateOn = types.Set{ElemType: types.StringType, Null: true}
+	stringDataV2.Nonce.Null = true
+	stringDataV2.CharsetSpec.Null = true
+	stringDataV2.CharWeights = types.Map{ElemType: types.Int64Type, Null: true}
+	stringDataV2.MustStartWithLetter.Null = true
+	stringDataV2.AlternateCase.Null = true
+	stringDataV2.EnsureAllClasses.Null = true
+	stringDataV2.MaxConsecutive.Null = true
+	stringDataV2.Exclude = types.List{ElemType: types.StringType, Null: true}
+	stringDataV2.ExcludeFile.Null = true
+	stringDataV2.ExcludeDictionary.Null = true
+	stringDataV2.Dictionary = types.List{ElemType: types.StringType, Null: true}
+	stringDataV2.CollisionGroup.Null = true
+	stringDataV2.ResultCount.Null = true
+	stringDataV2.Unique.Null = true
+	stringDataV2.Tokens = types.List{ElemType: types.StringType, Null: true}
+	stringDataV2.TokenCount.Null = true
+	stringDataV2.TokenSeparator.Null = true
+	stringDataV2.Results = types.List{ElemType: types.StringType, Null: true}
+	stringDataV2.Characters = stringCharacters(stringDataV1.Result.Value)
+	stringDataV2.ResultLength = types.Int64{Value: int64(utf8.RuneCountInString(stringDataV1.Result.Value))}
+
 	diags := resp.State.Set(ctx, stringDataV2)
 	resp.Diagnostics.Append(diags...)
 }
 
 type stringModelV2 struct {
-	ID              types.String `tfsdk:"id"`
-	Keepers         types.Map    `tfsdk:"keepers"`
-	Length          types.Int64  `tfsdk:"length"`
-	Special         types.Bool   `tfsdk:"special"`
-	Upper           types.Bool   `tfsdk:"upper"`
-	Lower           types.Bool   `tfsdk:"lower"`
-	Numeric         types.Bool   `tfsdk:"numeric"`
-	MinNumeric      types.Int64  `tfsdk:"min_numeric"`
-	MinUpper        types.Int64  `tfsdk:"min_upper"`
-	MinLower        types.Int64  `tfsdk:"min_lower"`
-	MinSpecial      types.Int64  `tfsdk:"min_special"`
-	OverrideSpecial types.String `tfsdk:"override_special"`
-	Result          types.String `tfsdk:"result"`
+	ID                  types.String `tfsdk:"id"`
+	Keepers             types.Map    `tfsdk:"keepers"`
+	RegenerateOn        types.Set    `tfsdk:"regenerate_on"`
+	Nonce               types.Int64  `tfsdk:"nonce"`
+	Length              types.Int64  `tfsdk:"length"`
+	Special             types.Bool   `tfsdk:"special"`
+	Upper               types.Bool   `tfsdk:"upper"`
+	Lower               types.Bool   `tfsdk:"lower"`
+	Numeric             types.Bool   `tfsdk:"numeric"`
+	MinNumeric          types.Int64  `tfsdk:"min_numeric"`
+	MinUpper            types.Int64  `tfsdk:"min_upper"`
+	MinLower            types.Int64  `tfsdk:"min_lower"`
+	MinSpecial          types.Int64  `tfsdk:"min_special"`
+	OverrideSpecial     types.String `tfsdk:"override_special"`
+	CharsetSpec         types.String `tfsdk:"charset_spec"`
+	CharWeights         types.Map    `tfsdk:"char_weights"`
+	MustStartWithLetter types.Bool   `tfsdk:"must_start_with_letter"`
+	AlternateCase       types.Bool   `tfsdk:"alternate_case"`
+	EnsureAllClasses    types.Bool   `tfsdk:"ensure_all_classes"`
+	MaxConsecutive      types.Int64  `tfsdk:"max_consecutive"`
+	Exclude             types.List   `tfsdk:"exclude"`
+	ExcludeFile         types.String `tfsdk:"exclude_file"`
+	ExcludeDictionary   types.Bool   `tfsdk:"exclude_dictionary"`
+	Dictionary          types.List   `tfsdk:"dictionary"`
+	CollisionGroup      types.String `tfsdk:"collision_group"`
+	ResultCount         types.Int64  `tfsdk:"result_count"`
+	Unique              types.Bool   `tfsdk:"unique"`
+	Tokens              types.List   `tfsdk:"tokens"`
+	TokenCount          types.Int64  `tfsdk:"token_count"`
+	TokenSeparator      types.String `tfsdk:"token_separator"`
+	Result              types.String `tfsdk:"result"`
+	Results             types.List   `tfsdk:"results"`
+	Characters          types.List   `tfsdk:"characters"`
+	ResultLength        types.Int64  `tfsdk:"result_length"`
+}
+
+// stringEnsureAllClasses raises the minimum of every enabled character class in params to at least 1.
+func stringEnsureAllClasses(params *random.StringParams) {
+	for _, class := range []struct {
+		enabled bool
+		min     *int64
+	}{
+		{params.Upper, &params.MinUpper},
+		{params.Lower, &params.MinLower},
+		{params.Numeric, &params.MinNumeric},
+		{params.Special, &params.MinSpecial},
+	} {
+		if class.enabled && *class.min < 1 {
+			*class.min = 1
+		}
+	}
+}
+
+// stringDistinctChars returns the number of different characters that the result of params can be drawn from.
+func stringDistinctChars(params random.StringParams) int {
+	if params.Weights != nil {
+		n := 0
+		for _, weight := range params.Weights {
+			if weight > 0 {
+				n++
+			}
+		}
+
+		return n
+	}
+
+	distinct := make(map[rune]struct{})
+	for _, c := range params.Chars() {
+		distinct[c] = struct{}{}
+	}
+
+	return len(distinct)
+}
+
+// stringWordsAvoidable reports whether any enabled character of params, repeated to the length of the result,
+// contains none of params.ExcludeWords. When it does not, every result contains a word. Minimums are not taken into
+// account, so generation may still fail when it does.
+func stringWordsAvoidable(params random.StringParams) bool {
+	chars := params.Chars()
+	if params.Weights != nil {
+		chars = ""
+		for c, weight := range params.Weights {
+			if weight > 0 {
+				chars += string(c)
+			}
+		}
+	}
+
+	for _, c := range chars {
+		run := strings.ToLower(strings.Repeat(string(c), int(params.Length)))
+
+		avoided := true
+		for _, w := range params.ExcludeWords {
+			if strings.Contains(run, strings.ToLower(w)) {
+				avoided = false
+				break
+			}
+		}
+
+		if avoided {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stringCreateError returns the diagnostics for an error returned by random.CreateString with params.
+func stringCreateError(err error, params random.StringParams) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case errors.Is(err, random.ErrMaxAttempts) && params.ExcludeRepeated:
+		diags.AddError(
+			"Create Random String Error",
+			fmt.Sprintf("Unable to generate a result that is not in exclude and has no more than max_consecutive repeated characters within %d attempts. ", random.MaxAttempts)+
+				"Reduce the number of excluded values, increase max_consecutive, enable more characters or reduce length.",
+		)
+	case errors.Is(err, random.ErrMaxAttempts) && len(params.ExcludeWords) > 0:
+		diags.AddError(
+			"Create Random String Error",
+			fmt.Sprintf("Unable to generate a result that is not in exclude and contains no word of the dictionary within %d attempts. ", random.MaxAttempts)+
+				"Remove short words from dictionary, enable more characters or reduce length.",
+		)
+	case errors.Is(err, random.ErrMaxAttempts):
+		diags.AddError(
+			"Create Random String Error",
+			fmt.Sprintf("Unable to generate a result that is not in exclude within %d attempts. ", random.MaxAttempts)+
+				"Reduce the number of excluded values or increase the number of possible results, e.g. by "+
+				"increasing length.",
+		)
+	default:
+		diags.Append(diagnostics.GenerationError("Create Random String Error", err)...)
+	}
+
+	return diags
+}
+
+// stringTokensCreateError returns the diagnostics for an error returned by random.CreateTokenString.
+func stringTokensCreateError(err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if errors.Is(err, random.ErrMaxAttempts) {
+		diags.AddError(
+			"Create Random String Error",
+			fmt.Sprintf("Unable to generate a result that is not in exclude within %d attempts. ", random.MaxAttempts)+
+				"Reduce the number of excluded values, add more tokens or increase token_count.",
+		)
+		return diags
+	}
+
+	diags.Append(diagnostics.GenerationError("Create Random String Error", err)...)
+
+	return diags
+}
+
+// stringTokensKeyspaceAtLeast reports whether at least n different strings can be generated with params, counting
+// every combination of the distinct tokens. Exclusions, and combinations of tokens that join to the same string, are
+// not taken into account, so fewer strings may actually be possible.
+func stringTokensKeyspaceAtLeast(params random.TokenParams, n int64) bool {
+	distinct := make(map[string]struct{}, len(params.Tokens))
+	for _, t := range params.Tokens {
+		distinct[t] = struct{}{}
+	}
+
+	tokens := int64(len(distinct))
+	if tokens < 2 {
+		return n <= 1
+	}
+
+	size := int64(1)
+	for i := int64(0); i < params.Count; i++ {
+		// Returning before size * tokens reaches n keeps it from overflowing.
+		if size > (n-1)/tokens {
+			return true
+		}
+
+		size *= tokens
+	}
+
+	return size >= n
+}
+
+// stringKeyspaceAtLeast reports whether at least n different strings can be generated with params, counting every
+// combination of the enabled characters. Minimums, exclusions and max_consecutive are not taken into account, so
+// fewer strings may actually be possible.
+func stringKeyspaceAtLeast(params random.StringParams, n int64) bool {
+	chars := int64(stringDistinctChars(params))
+	if chars < 2 {
+		return n <= 1
+	}
+
+	size := int64(1)
+	for i := int64(0); i < params.Length; i++ {
+		// Returning before size * chars reaches n keeps it from overflowing.
+		if size > (n-1)/chars {
+			return true
+		}
+
+		size *= chars
+	}
+
+	return size >= n
+}
+
+// stringExcludeFile returns the non-blank lines of the file at path. A file that does not exist is treated as empty.
+func stringExcludeFile(path string) ([]string, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if line != "" {
+			values = append(values, line)
+		}
+	}
+
+	return values, nil
+}
+
+// stringCharacters splits result into a list holding each of its characters as a separate element.
+func stringCharacters(result string) types.List {
+	elems := make([]attr.Value, 0, len(result))
+	for _, r := range result {
+		elems = append(elems, types.String{Value: string(r)})
+	}
+
+	return types.List{
+		Elems:    elems,
+		ElemType: types.StringType,
+	}
 }
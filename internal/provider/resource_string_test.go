@@ -2,83 +2,1009 @@ package provider
 
 import (
 	"fmt"
+	"math"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
-func TestAccResourceString(t *testing.T) {
+func TestAccResourceString(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "basic" {
+							length = 12
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_string.basic", "result", testCheckLen(12)),
+				),
+			},
+			{
+				ResourceName:      "random_string.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The character classes are inferred from the imported result, which need not contain a
+				// character of every enabled class.
+				ImportStateVerifyIgnore: []string{"upper", "lower", "numeric", "special"},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Characters(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "characters" {
+							length = 6
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_string.characters", "characters.#", "6"),
+					testAccResourceStringCheckCharacters("random_string.characters"),
+				),
+			},
+			{
+				ResourceName:      "random_string.characters",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The character classes are inferred from the imported result, which need not contain a
+				// character of every enabled class.
+				ImportStateVerifyIgnore: []string{"upper", "lower", "numeric", "special"},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ResultLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "ascii" {
+							length = 12
+						}
+						resource "random_string" "special" {
+							length           = 1
+							upper            = false
+							lower            = false
+							numeric          = false
+							override_special = "#"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_string.ascii", "result_length", "12"),
+					resource.TestCheckResourceAttr("random_string.special", "result", "#"),
+					resource.TestCheckResourceAttr("random_string.special", "result_length", "1"),
+				),
+			},
+			{
+				ResourceName:      "random_string.ascii",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The character classes are inferred from the imported result, which need not contain a
+				// character of every enabled class.
+				ImportStateVerifyIgnore: []string{"upper", "lower", "numeric", "special"},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ImportInfersClasses(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "imported" {
+							length = 4
+						}`,
+			},
+			{
+				ResourceName:  "random_string.imported",
+				ImportState:   true,
+				ImportStateId: "test",
+				ImportStateCheck: testAccCheckImportedClasses(map[string]string{
+					"length":  "4",
+					"upper":   "false",
+					"lower":   "true",
+					"numeric": "false",
+					"special": "false",
+				}),
+			},
+			{
+				ResourceName:  "random_string.imported",
+				ImportState:   true,
+				ImportStateId: "Ab1-ü",
+				ImportStateCheck: testAccCheckImportedClasses(map[string]string{
+					"length":  "5",
+					"upper":   "true",
+					"lower":   "true",
+					"numeric": "true",
+					"special": "true",
+				}),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_CollisionGroup(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "digit" {
+							count           = 10
+							length          = 1
+							upper           = false
+							lower           = false
+							special         = false
+							collision_group = "digits"
+						}`,
+				Check: testAccCheckDistinctResults("random_string.digit", 10, "result"),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_CollisionGroupErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "digit" {
+							count           = 11
+							length          = 1
+							upper           = false
+							lower           = false
+							special         = false
+							collision_group = "digits"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Unable to generate a result that does not collide with the other results in\ncollision group "digits" within 1000 attempts`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Override(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "override" {
+							length = 4
+							override_special = "!"
+							lower = false
+							upper = false
+							numeric = false
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_string.override", "result", testCheckLen(4)),
+					resource.TestCheckResourceAttr("random_string.override", "result", "!!!!"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Min(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "min" {
+							length = 12
+							override_special = "!#@"
+							min_lower = 2
+							min_upper = 3
+							min_special = 1
+							min_numeric = 4
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_string.min", "result", testCheckLen(12)),
+					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`([a-z].*){2,}`)),
+					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`([A-Z].*){3,}`)),
+					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`([0-9].*){4,}`)),
+					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`([!#@].*)`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MustStartWithLetter(t *testing.T) {
+	var checks []resource.TestCheckFunc
+	for i := 0; i < 20; i++ {
+		checks = append(checks, resource.TestMatchResourceAttr(
+			fmt.Sprintf("random_string.letter.%d", i), "result", regexp.MustCompile(`^[A-Za-z]`),
+		))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "letter" {
+							count = 20
+							length = 8
+							must_start_with_letter = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MustStartWithLetterMinimums(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "letter" {
+							length = 10
+							upper = false
+							special = false
+							min_numeric = 9
+							must_start_with_letter = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_string.letter", "result", regexp.MustCompile(`^[a-z][0-9]{9}$`)),
+				),
+			},
+			{
+				Config: `resource "random_string" "letter" {
+							length = 3
+							min_upper = 1
+							min_numeric = 2
+							must_start_with_letter = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_string.letter", "result", regexp.MustCompile(`^[A-Z][0-9]{2}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MustStartWithLetterErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "letter" {
+							length = 8
+							upper = false
+							lower = false
+							must_start_with_letter = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*At least one of upper or lower needs to be true when must_start_with_letter`),
+			},
+			{
+				Config: `resource "random_string" "letter" {
+							length = 4
+							min_numeric = 4
+							must_start_with_letter = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*The length needs to be greater than \(min_numeric \+ min_special\) when`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_AlternateCase(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "alternate" {
+							length = 64
+							upper = false
+							numeric = false
+							special = false
+							alternate_case = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_string.alternate", "result", regexp.MustCompile(`^[a-zA-Z]{64}$`)),
+					resource.TestMatchResourceAttr("random_string.alternate", "result", regexp.MustCompile(`[A-Z]`)),
+					resource.TestMatchResourceAttr("random_string.alternate", "result", regexp.MustCompile(`[a-z]`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_AlternateCaseErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "alternate" {
+							length = 12
+							min_upper = 2
+							alternate_case = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*The min_upper and min_lower arguments cannot be used when alternate_case is\strue.`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Exclude(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "exclude" {
+							length = 1
+							upper = false
+							lower = false
+							special = false
+							exclude = ["0", "1", "2", "3", "4", "5", "6", "7", "8"]
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_string.exclude", "result", "9"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ExcludeErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "exclude" {
+							length = 1
+							upper = false
+							lower = false
+							special = false
+							exclude = ["0", "1", "2", "3", "4", "5", "6", "7", "8", "9"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Unable to generate a result that is not in exclude within 1000 attempts`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_EnsureAllClasses(t *testing.T) {
+	checks := make([]resource.TestCheckFunc, 0, 140)
+	for i := 0; i < 20; i++ {
+		allClasses := fmt.Sprintf("random_string.all_classes.%d", i)
+		noSpecial := fmt.Sprintf("random_string.no_special.%d", i)
+
+		checks = append(checks,
+			resource.TestMatchResourceAttr(allClasses, "result", regexp.MustCompile(`[A-Z]`)),
+			resource.TestMatchResourceAttr(allClasses, "result", regexp.MustCompile(`[a-z]`)),
+			resource.TestMatchResourceAttr(allClasses, "result", regexp.MustCompile(`[0-9]`)),
+			resource.TestMatchResourceAttr(allClasses, "result", regexp.MustCompile(`[!#@]`)),
+			resource.TestMatchResourceAttr(noSpecial, "result", regexp.MustCompile(`[A-Z]`)),
+			resource.TestMatchResourceAttr(noSpecial, "result", regexp.MustCompile(`[a-z]`)),
+			resource.TestMatchResourceAttr(noSpecial, "result", regexp.MustCompile(`[0-9]`)),
+		)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// With only four characters, most strings miss at least one of the four classes.
+				Config: `resource "random_string" "all_classes" {
+							count              = 20
+							length             = 4
+							override_special   = "!#@"
+							ensure_all_classes = true
+						}
+						resource "random_string" "no_special" {
+							count              = 20
+							length             = 3
+							special            = false
+							ensure_all_classes = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+			{
+				Config: `resource "random_string" "min" {
+							length             = 5
+							override_special   = "!#@"
+							min_numeric        = 2
+							ensure_all_classes = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`[A-Z]`)),
+					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`[a-z]`)),
+					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`([0-9].*){2,}`)),
+					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`[!#@]`)),
+					resource.TestCheckResourceAttr("random_string.min", "min_upper", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_EnsureAllClassesErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "all_classes" {
+							length             = 4
+							min_numeric        = 2
+							ensure_all_classes = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*The length needs to be at least \(min_upper \+ min_lower \+ min_numeric \+\nmin_special\) when ensure_all_classes is true, counting a minimum of 1 for\nevery enabled class.`),
+			},
+			{
+				Config: `resource "random_string" "all_classes" {
+							length             = 4
+							charset_spec       = "[:alnum:]"
+							ensure_all_classes = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "charset_spec" cannot be specified when "ensure_all_classes" is\nspecified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MaxConsecutive(t *testing.T) {
+	checks := make([]resource.TestCheckFunc, 0, 20)
+	for i := 0; i < 20; i++ {
+		checks = append(checks, resource.TestCheckResourceAttrWith(fmt.Sprintf("random_string.max_consecutive.%d", i), "result", testCheckMaxConsecutive(2)))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// With only ten digits, most strings of this length contain a run of three.
+				Config: `resource "random_string" "max_consecutive" {
+							count           = 20
+							length          = 64
+							upper           = false
+							lower           = false
+							special         = false
+							max_consecutive = 2
+						}`,
+				Check: resource.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Unique(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "unique" {
+							length       = 2
+							charset_spec = "01234"
+							result_count = 25
+							unique       = true
+						}
+						resource "random_string" "repeated" {
+							length       = 1
+							charset_spec = "ab"
+							result_count = 10
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_string.unique", "results.#", "25"),
+					resource.TestCheckResourceAttrPair("random_string.unique", "results.0", "random_string.unique", "result"),
+					testAccResourceStringCheckUnique("random_string.unique"),
+					resource.TestCheckResourceAttr("random_string.repeated", "results.#", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_UniqueErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "unique" {
+							length       = 2
+							charset_spec = "01234"
+							result_count = 26
+							unique       = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*There are fewer possible results than result_count \(26\)`),
+			},
+			{
+				Config: `resource "random_string" "unique" {
+							length = 8
+							unique = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "result_count" must be specified when "unique" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ResultCountErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "results" {
+							length       = 8
+							result_count = 10001
+						}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*Value must be between 1 and 10000, got: 10001`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ExcludeDictionary(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "builtin" {
+							length             = 16
+							upper              = false
+							numeric            = false
+							special            = false
+							exclude_dictionary = true
+							result_count       = 50
+						}
+						resource "random_string" "custom" {
+							length             = 6
+							upper              = false
+							numeric            = false
+							special            = false
+							exclude_dictionary = true
+							dictionary         = ["a", "E", "i", "o", "u"]
+							result_count       = 50
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_string.builtin", "results.#", "50"),
+					testAccResourceStringCheckNoWords("random_string.builtin", random.DictionaryWords()),
+					resource.TestCheckResourceAttr("random_string.custom", "results.#", "50"),
+					testAccResourceStringCheckNoWords("random_string.custom", []string{"a", "e", "i", "o", "u"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ExcludeDictionaryErrors(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
-				Config: `resource "random_string" "basic" {
-							length = 12
+				Config: `resource "random_string" "words" {
+							length             = 8
+							charset_spec       = "0-9"
+							exclude_dictionary = true
+							dictionary         = ["0", "11", "2222", "3", "4", "5", "6", "7", "8", "9"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Every enabled character forms a word of the dictionary`),
+			},
+			{
+				Config: `resource "random_string" "words" {
+							length             = 30
+							charset_spec       = "ab"
+							exclude_dictionary = true
+							dictionary         = ["ab", "ba"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Unable to generate a result that is not in exclude and contains no word of\nthe dictionary within 1000 attempts`),
+			},
+			{
+				Config: `resource "random_string" "words" {
+							length             = 8
+							exclude_dictionary = true
+							dictionary         = ["pass", ""]
+						}`,
+				ExpectError: regexp.MustCompile(`.*String length must be at least 1, got: 0`),
+			},
+			{
+				Config: `resource "random_string" "words" {
+							length     = 8
+							dictionary = ["pass"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "exclude_dictionary" must be specified when "dictionary" is\nspecified`),
+			},
+		},
+	})
+}
+
+func TestStringKeyspaceAtLeast(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params random.StringParams
+		n      int64
+		want   bool
+	}{
+		"exact": {
+			params: random.StringParams{Length: 2, Charset: "01234"},
+			n:      25,
+			want:   true,
+		},
+		"too small": {
+			params: random.StringParams{Length: 2, Charset: "01234"},
+			n:      26,
+			want:   false,
+		},
+		"single character": {
+			params: random.StringParams{Length: 16, Charset: "a"},
+			n:      2,
+			want:   false,
+		},
+		"overflow": {
+			params: random.StringParams{Length: 64, Upper: true, Lower: true, Numeric: true, Special: true},
+			n:      math.MaxInt64,
+			want:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := stringKeyspaceAtLeast(testCase.params, testCase.n); got != testCase.want {
+				t.Errorf("expected %t, got %t", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestAccResourceString_MaxConsecutiveLong(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// Almost no strings of this length are free of repeats, so they cannot be found by re-drawing
+				// the whole string.
+				Config: `resource "random_string" "default" {
+							length          = 1024
+							max_consecutive = 1
+						}
+						resource "random_string" "two_characters" {
+							length          = 1024
+							charset_spec    = "ab"
+							max_consecutive = 1
 						}`,
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrWith("random_string.basic", "result", testCheckLen(12)),
+					resource.TestCheckResourceAttrWith("random_string.default", "result", testCheckLen(1024)),
+					resource.TestCheckResourceAttrWith("random_string.default", "result", testCheckMaxConsecutive(1)),
+					resource.TestCheckResourceAttrWith("random_string.two_characters", "result", testCheckLen(1024)),
+					resource.TestCheckResourceAttrWith("random_string.two_characters", "result", testCheckMaxConsecutive(1)),
 				),
 			},
+		},
+	})
+}
+
+func TestAccResourceString_MaxConsecutiveErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "max_consecutive" {
+							length          = 3
+							charset_spec    = "a"
+							max_consecutive = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*At least two different characters need to be enabled when max_consecutive is\nset and the length is greater than max_consecutive.`),
+			},
 			{
-				ResourceName:      "random_string.basic",
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: `resource "random_string" "max_consecutive" {
+							length          = 2
+							charset_spec    = "ab"
+							max_consecutive = 1
+							exclude         = ["ab", "ba"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*Unable to generate a result that is not in exclude and has no more than\nmax_consecutive repeated characters within 1000 attempts`),
+			},
+			{
+				Config: `resource "random_string" "max_consecutive" {
+							length          = 8
+							max_consecutive = 0
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
 			},
 		},
 	})
 }
 
-func TestAccResourceString_Override(t *testing.T) {
+func TestAccResourceString_ExcludeFile(t *testing.T) {
+	excludeFile := filepath.Join(t.TempDir(), "issued.txt")
+	if err := os.WriteFile(excludeFile, []byte("0\n1\n2\n\n3\r\n4\n5\n6\n7\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
-				Config: `resource "random_string" "override" {
-							length = 4
-							override_special = "!"
-							lower = false
+				Config: fmt.Sprintf(`resource "random_string" "exclude" {
+							length = 1
 							upper = false
-							numeric = false
-						}`,
+							lower = false
+							special = false
+							exclude = ["8"]
+							exclude_file = %q
+						}`, excludeFile),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrWith("random_string.override", "result", testCheckLen(4)),
-					resource.TestCheckResourceAttr("random_string.override", "result", "!!!!"),
+					resource.TestCheckResourceAttr("random_string.exclude", "result", "9"),
+					resource.TestCheckResourceAttr("random_string.exclude", "exclude_file", excludeFile),
 				),
 			},
 		},
 	})
 }
 
-func TestAccResourceString_Min(t *testing.T) {
+func TestAccResourceString_ExcludeFileMissing(t *testing.T) {
+	excludeFile := filepath.Join(t.TempDir(), "missing.txt")
+
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
-				Config: `resource "random_string" "min" {
+				Config: fmt.Sprintf(`resource "random_string" "exclude" {
 							length = 12
-							override_special = "!#@"
-							min_lower = 2
-							min_upper = 3
-							min_special = 1
-							min_numeric = 4
+							exclude_file = %q
+						}`, excludeFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("random_string.exclude", "result", testCheckLen(12)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ExcludeFileErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`resource "random_string" "exclude" {
+							length = 12
+							exclude_file = %q
+						}`, t.TempDir()),
+				ExpectError: regexp.MustCompile(`(?s).*The exclude_file.*could not be read.*is a directory`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_CharsetSpec(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "digit" {
+							length = 32
+							charset_spec = "[:digit:]"
+						}
+						resource "random_string" "xdigit" {
+							length = 32
+							charset_spec = "[:xdigit:]"
+						}
+						resource "random_string" "range" {
+							length = 32
+							charset_spec = "a-c[:upper:]"
 						}`,
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrWith("random_string.min", "result", testCheckLen(12)),
-					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`([a-z].*){2,}`)),
-					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`([A-Z].*){3,}`)),
-					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`([0-9].*){4,}`)),
-					resource.TestMatchResourceAttr("random_string.min", "result", regexp.MustCompile(`([!#@].*)`)),
+					resource.TestMatchResourceAttr("random_string.digit", "result", regexp.MustCompile(`^[0-9]{32}$`)),
+					resource.TestMatchResourceAttr("random_string.xdigit", "result", regexp.MustCompile(`^[0-9A-Fa-f]{32}$`)),
+					resource.TestMatchResourceAttr("random_string.range", "result", regexp.MustCompile(`^[a-cA-Z]{32}$`)),
+					resource.TestCheckResourceAttr("random_string.range", "charset_spec", "a-c[:upper:]"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_CharsetSpecErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "charset" {
+							length = 8
+							charset_spec = "[:emoji:]"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The charset_spec value "\[:emoji:\]" is invalid: unknown character class`),
+			},
+			{
+				Config: `resource "random_string" "charset" {
+							length = 8
+							charset_spec = ""
+						}`,
+				ExpectError: regexp.MustCompile(`.*The charset_spec value "" is invalid: the character set is empty`),
+			},
+			{
+				Config: `resource "random_string" "charset" {
+							length = 8
+							min_upper = 1
+							charset_spec = "[:digit:]"
+						}`,
+				ExpectError: regexp.MustCompile(`.*must_start_with_letter\narguments cannot be used when charset_spec is set`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_CharWeights(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "single" {
+							length = 16
+							char_weights = {
+								a = 1
+								b = 0
+							}
+						}
+						resource "random_string" "pair" {
+							length = 32
+							char_weights = {
+								x = 3
+								y = 1
+							}
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_string.single", "result", "aaaaaaaaaaaaaaaa"),
+					resource.TestMatchResourceAttr("random_string.pair", "result", regexp.MustCompile(`^[xy]{32}$`)),
 				),
 			},
 		},
 	})
 }
 
+func TestAccResourceString_CharWeightsErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "weights" {
+							length = 8
+							char_weights = {
+								ab = 1
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*The char_weights key "ab" is invalid: keys must be a single printable ASCII\ncharacter.`),
+			},
+			{
+				Config: `resource "random_string" "weights" {
+							length = 8
+							char_weights = {
+								a = 0
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*At least one char_weights value needs to be greater than zero.`),
+			},
+			{
+				Config: `resource "random_string" "weights" {
+							length = 8
+							char_weights = {
+								a = -1
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 0, got: -1`),
+			},
+			{
+				Config: `resource "random_string" "weights" {
+							length = 8
+							charset_spec = "a-z"
+							char_weights = {
+								a = 1
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute "charset_spec" cannot be specified when "char_weights" is specified`),
+			},
+		},
+	})
+}
+
 // TestAccResourceString_StateUpgradeV1toV2 covers the state upgrade from V1 to V2.
 // This includes the deprecation and removal of `number` and the addition of `numeric` attributes.
 // v3.2.0 was used as this is the last version before `number` was deprecated and `numeric` attribute
 // was added.
+func TestAccResourceString_Tokens(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "tokens" {
+							tokens          = ["ka", "lo", "mi"]
+							token_count     = 4
+							token_separator = "-"
+						}
+						resource "random_string" "joined" {
+							tokens       = ["a", "b"]
+							token_count  = 3
+							exclude      = ["aaa"]
+							result_count = 7
+							unique       = true
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_string.tokens", "result", regexp.MustCompile(`^(ka|lo|mi)(-(ka|lo|mi)){3}$`)),
+					resource.TestCheckResourceAttr("random_string.tokens", "result_length", "11"),
+					resource.TestCheckNoResourceAttr("random_string.tokens", "length"),
+					resource.TestMatchResourceAttr("random_string.joined", "result", regexp.MustCompile(`^[ab]{3}$`)),
+					resource.TestCheckResourceAttr("random_string.joined", "results.#", "7"),
+					testAccResourceStringCheckUnique("random_string.joined"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_TokensErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "tokens" {
+							tokens      = []
+							token_count = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*List must contain at least 1 elements, got: 0`),
+			},
+			{
+				Config: `resource "random_string" "tokens" {
+							tokens = ["ka"]
+						}`,
+				ExpectError: regexp.MustCompile(`.*The token_count argument needs to be set when tokens is set`),
+			},
+			{
+				Config: `resource "random_string" "tokens" {
+							length      = 8
+							tokens      = ["ka"]
+							token_count = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*The length argument cannot be set when tokens is set`),
+			},
+			{
+				Config: `resource "random_string" "tokens" {
+							token_count = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*The length argument needs to be set unless tokens is set`),
+			},
+			{
+				Config: `resource "random_string" "tokens" {
+							tokens      = ["ka", null]
+							token_count = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*The tokens list needs to contain strings only, got null at index 1`),
+			},
+			{
+				Config: `resource "random_string" "tokens" {
+							tokens      = ["ka"]
+							token_count = 1025
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be between 1 and 1024, got: 1025`),
+			},
+			{
+				Config: `resource "random_string" "tokens" {
+							tokens       = ["a", "b"]
+							token_count  = 2
+							result_count = 5
+							unique       = true
+						}`,
+				ExpectError: regexp.MustCompile(`.*There are fewer possible results than result_count \(5\) for the given tokens`),
+			},
+		},
+	})
+}
+
 func TestAccResourceString_StateUpgradeV1toV2(t *testing.T) {
 	t.Parallel()
 
@@ -371,6 +1297,69 @@ func TestAccResourceString_UpgradeFromVersion3_3_2(t *testing.T) {
 	})
 }
 
+func testAccResourceStringCheckCharacters(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		result := rs.Primary.Attributes["result"]
+
+		var joined string
+		for i := 0; i < len(result); i++ {
+			c := rs.Primary.Attributes[fmt.Sprintf("characters.%d", i)]
+			if len(c) != 1 {
+				return fmt.Errorf("expected characters.%d to be a single character, got %q", i, c)
+			}
+			joined += c
+		}
+
+		if joined != result {
+			return fmt.Errorf("expected characters to join to %q, got %q", result, joined)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckImportedClasses checks that the single imported state has the given attribute values.
+func testAccCheckImportedClasses(expected map[string]string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 state, got %d", len(states))
+		}
+
+		for k, v := range expected {
+			if actual := states[0].Attributes[k]; actual != v {
+				return fmt.Errorf("expected %s to be %q, got %q", k, v, actual)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testCheckMaxConsecutive(maxConsecutive int) func(input string) error {
+	return func(input string) error {
+		run := 1
+
+		for i := 1; i < len(input); i++ {
+			if input[i] == input[i-1] {
+				run++
+			} else {
+				run = 1
+			}
+
+			if run > maxConsecutive {
+				return fmt.Errorf("%q contains more than %d consecutive %q", input, maxConsecutive, input[i])
+			}
+		}
+
+		return nil
+	}
+}
+
 func testCheckLen(expectedLen int) func(input string) error {
 	return func(input string) error {
 		if len(input) != expectedLen {
@@ -391,3 +1380,90 @@ func testCheckMinLen(minLen int) func(input string) error {
 		return nil
 	}
 }
+
+func testAccResourceStringCheckUnique(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["results.#"])
+		if err != nil {
+			return err
+		}
+
+		seen := make(map[string]struct{}, count)
+		for i := 0; i < count; i++ {
+			v := rs.Primary.Attributes[fmt.Sprintf("results.%d", i)]
+			if _, ok := seen[v]; ok {
+				return fmt.Errorf("expected results to be distinct, got %q more than once", v)
+			}
+
+			seen[v] = struct{}{}
+		}
+
+		return nil
+	}
+}
+
+// testAccResourceStringCheckNoWords checks that no element of results of the named resource contains any of words,
+// ignoring case.
+func testAccResourceStringCheckNoWords(name string, words []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["results.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			v := strings.ToLower(rs.Primary.Attributes[fmt.Sprintf("results.%d", i)])
+			for _, w := range words {
+				if strings.Contains(v, strings.ToLower(w)) {
+					return fmt.Errorf("expected results to contain no dictionary words, got %q containing %q", v, w)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestStringWordsAvoidable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params random.StringParams
+		want   bool
+	}{
+		"avoidable": {
+			params: random.StringParams{Length: 4, Charset: "ab", ExcludeWords: []string{"a", "bbbbb"}},
+			want:   true,
+		},
+		"every run is a word": {
+			params: random.StringParams{Length: 4, Charset: "ab", ExcludeWords: []string{"A", "bbbb"}},
+			want:   false,
+		},
+		"weights": {
+			params: random.StringParams{Length: 4, Weights: map[byte]int64{'a': 1, 'b': 0}, ExcludeWords: []string{"a"}},
+			want:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := stringWordsAvoidable(testCase.params); got != testCase.want {
+				t.Errorf("expected %t, got %t", testCase.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// templateMaxHexLength is the maximum number of characters a {hex:n} placeholder may produce.
+const templateMaxHexLength = 256
+
+// templateIntPattern matches the bounds of an {int:min-max} placeholder, either of which may be negative.
+var templateIntPattern = regexp.MustCompile(`^(-?[0-9]+)-(-?[0-9]+)$`)
+
+var _ tfsdk.ResourceType = (*templateResourceType)(nil)
+
+type templateResourceType struct{}
+
+func (r *templateResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_template` fills the placeholders of a string template with random " +
+			"values, e.g. `user-{int:1000-9999}-{word}` produces a value such as `user-4821-lemur`.\n" +
+			"\n" +
+			"This resource *does not* use a cryptographic random number generator and should not be used " +
+			"for secrets.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"template": {
+				Description: "The template to fill. Every placeholder is replaced by a newly drawn value:\n" +
+					"\n" +
+					"  * `{int:min-max}`: an integer between `min` and `max` inclusive, e.g. `{int:1000-9999}` or " +
+					"`{int:-5-5}`.\n" +
+					"  * `{word}`: a word from the list of names used by `random_pet`.\n" +
+					"  * `{hex:n}`: `n` lowercase hexadecimal characters, where `n` is between 1 and " +
+					strconv.Itoa(templateMaxHexLength) + ".\n" +
+					"  * `{uuid}`: a version 4 UUID.\n" +
+					"\n" +
+					"All other text is copied as-is. The template must contain at least one placeholder.",
+				Type:     types.StringType,
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile results.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same result " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The template with its placeholders replaced.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *templateResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &templateResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*templateResource)(nil)
+
+type templateResource struct {
+	source *randSource
+}
+
+func (r *templateResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan templateModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := templateFill(r.source.newRand(plan.Seed.Value, plan.Salt.Value), plan.Template.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Template Error",
+			fmt.Sprintf("The template value is invalid: %s.", err),
+		)
+		return
+	}
+
+	t := templateModelV0{
+		ID:       staticID(),
+		Keepers:  plan.Keepers,
+		Template: plan.Template,
+		Seed:     plan.Seed,
+		Salt:     plan.Salt,
+		Result:   types.String{Value: result},
+	}
+
+	diags = resp.State.Set(ctx, t)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *templateResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *templateResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *templateResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+// templateFill replaces every placeholder in template with a value drawn from rand. An error is returned when
+// template has no placeholders, or contains a malformed, unknown or out of bounds placeholder.
+func templateFill(rand *rand.Rand, template string) (string, error) {
+	var b strings.Builder
+	placeholders := 0
+
+	for rest := template; rest != ""; {
+		start := strings.IndexByte(rest, '{')
+		if start == -1 {
+			b.WriteString(rest)
+			break
+		}
+
+		end := strings.IndexByte(rest[start:], '}')
+		if end == -1 {
+			return "", fmt.Errorf("unterminated placeholder %q", rest[start:])
+		}
+
+		b.WriteString(rest[:start])
+
+		value, err := templatePlaceholder(rand, rest[start+1:start+end])
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(value)
+		placeholders++
+		rest = rest[start+end+1:]
+	}
+
+	if placeholders == 0 {
+		return "", errors.New("the template needs to contain at least one placeholder")
+	}
+
+	return b.String(), nil
+}
+
+// templatePlaceholder returns a value drawn from rand for the placeholder with the given contents, i.e. the text
+// between its braces.
+func templatePlaceholder(rand *rand.Rand, placeholder string) (string, error) {
+	kind, arg, hasArg := placeholder, "", false
+	if i := strings.IndexByte(placeholder, ':'); i != -1 {
+		kind, arg, hasArg = placeholder[:i], placeholder[i+1:], true
+	}
+
+	switch {
+	case kind == "int" && hasArg:
+		m := templateIntPattern.FindStringSubmatch(arg)
+		if m == nil {
+			return "", fmt.Errorf("the placeholder {%s} needs to be of the form {int:min-max}", placeholder)
+		}
+
+		min, errMin := strconv.ParseInt(m[1], 10, 64)
+		max, errMax := strconv.ParseInt(m[2], 10, 64)
+		if errMin != nil || errMax != nil {
+			return "", fmt.Errorf("the bounds of the placeholder {%s} need to fit within a 64-bit integer", placeholder)
+		}
+
+		if min > max {
+			return "", fmt.Errorf("the minimum of the placeholder {%s} needs to be smaller than or equal to its maximum", placeholder)
+		}
+
+		// The span is computed as an unsigned value so that bounds spanning most of int64 do not overflow.
+		span := uint64(max) - uint64(min)
+		if span >= math.MaxInt64 {
+			return "", fmt.Errorf("the range of the placeholder {%s} needs to contain fewer than %d values", placeholder, uint64(math.MaxInt64))
+		}
+
+		return strconv.FormatInt(int64(uint64(min)+uint64(rand.Int63n(int64(span)+1))), 10), nil
+	case kind == "word" && !hasArg:
+		return random.CreatePetName(rand, random.DefaultPetWords(), 1, ""), nil
+	case kind == "hex" && hasArg:
+		n, err := strconv.Atoi(arg)
+		if err != nil || n < 1 || n > templateMaxHexLength {
+			return "", fmt.Errorf("the placeholder {%s} needs to be of the form {hex:n}, where n is between 1 and %d", placeholder, templateMaxHexLength)
+		}
+
+		bytes := make([]byte, (n+1)/2)
+		_, _ = rand.Read(bytes)
+
+		return hex.EncodeToString(bytes)[:n], nil
+	case kind == "uuid" && !hasArg:
+		return uuid.FormatUUID(random.SeededUUIDv4(rand))
+	default:
+		return "", fmt.Errorf("unknown placeholder {%s}, expected {int:min-max}, {word}, {hex:n} or {uuid}", placeholder)
+	}
+}
+
+type templateModelV0 struct {
+	ID       types.String `tfsdk:"id"`
+	Keepers  types.Map    `tfsdk:"keepers"`
+	Template types.String `tfsdk:"template"`
+	Seed     types.String `tfsdk:"seed"`
+	Salt     types.String `tfsdk:"salt"`
+	Result   types.String `tfsdk:"result"`
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTemplate(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_template" "template" {
+							template = "user-{int:1000-9999}-{word}/{hex:5}/{uuid}/{int:-1--1}"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("random_template.template", "result", regexp.MustCompile(
+						`^user-[1-9][0-9]{3}-[a-z]+/[0-9a-f]{5}/[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}/-1$`,
+					)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceTemplate_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_template" "template_1" {
+							template = "user-{int:1000-9999}-{word}-{hex:3}"
+							seed     = "12345"
+						}
+						resource "random_template" "template_2" {
+							template = "user-{int:1000-9999}-{word}-{hex:3}"
+							seed     = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_template.template_1", "result", "user-7098-bullfrog-3d6"),
+					resource.TestCheckResourceAttrPair("random_template.template_1", "result", "random_template.template_2", "result"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceTemplate_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_template" "template" {
+							template = "no placeholders"
+						}`,
+				ExpectError: regexp.MustCompile(`.*the\s+template\s+needs\s+to\s+contain\s+at\s+least\s+one\s+placeholder`),
+			},
+			{
+				Config: `resource "random_template" "template" {
+							template = "user-{int:1000-9999"
+						}`,
+				ExpectError: regexp.MustCompile(`.*unterminated\s+placeholder\s+"{int:1000-9999"`),
+			},
+			{
+				Config: `resource "random_template" "template" {
+							template = "{int:9999-1000}"
+						}`,
+				ExpectError: regexp.MustCompile(`.*the\s+minimum\s+of\s+the\s+placeholder\s+{int:9999-1000}\s+needs\s+to\s+be\s+smaller\s+than\s+or\s+equal\s+to\s+its\s+maximum`),
+			},
+			{
+				Config: `resource "random_template" "template" {
+							template = "{int:1000}"
+						}`,
+				ExpectError: regexp.MustCompile(`.*the\s+placeholder\s+{int:1000}\s+needs\s+to\s+be\s+of\s+the\s+form\s+{int:min-max}`),
+			},
+			{
+				Config: `resource "random_template" "template" {
+							template = "{int:-9223372036854775808-9223372036854775807}"
+						}`,
+				ExpectError: regexp.MustCompile(`.*the\s+range\s+of\s+the\s+placeholder\s+\S+\s+needs\s+to\s+contain\s+fewer\s+than`),
+			},
+			{
+				Config: `resource "random_template" "template" {
+							template = "{hex:0}"
+						}`,
+				ExpectError: regexp.MustCompile(`.*the\s+placeholder\s+{hex:0}\s+needs\s+to\s+be\s+of\s+the\s+form\s+{hex:n},\s+where\s+n\s+is\s+between\s+1\s+and\s+256`),
+			},
+			{
+				Config: `resource "random_template" "template" {
+							template = "{name}"
+						}`,
+				ExpectError: regexp.MustCompile(`.*unknown\s+placeholder\s+{name},\s+expected\s+{int:min-max},\s+{word},\s+{hex:n}\s+or\s+{uuid}`),
+			},
+		},
+	})
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/planmodifiers"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var _ tfsdk.ResourceType = (*treeResourceType)(nil)
+
+var (
+	treeLeafTypes     = stringEnum{"string", "number", "bool"}
+	treeOutputFormats = stringEnum{"json", "yaml", "toml"}
+)
+
+type treeResourceType struct{}
+
+func (r *treeResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "The resource `random_tree` generates a random tree of nested maps, presented as a JSON " +
+			"object or as a YAML or TOML document, e.g. for testing configuration generators. Each map has between 1 and `max_breadth` " +
+			"entries with random keys, and each entry is either a leaf value or, up to `max_depth` levels, " +
+			"another map.",
+		Attributes: map[string]tfsdk.Attribute{
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"max_depth": {
+				Description: "The maximum number of levels of nested maps, including the outermost map. The " +
+					"minimum value is 1.",
+				Type:     types.Int64Type,
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_breadth": {
+				Description: "The maximum number of entries in each map. The minimum value is 1. The largest " +
+					fmt.Sprintf("possible tree for `max_depth` and `max_breadth` may not exceed %d nodes.", random.MaxTreeNodes),
+				Type:     types.Int64Type,
+				Required: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.AtLeast(1),
+				},
+			},
+			"leaf_type": {
+				Description: "The type of leaf values. " + treeLeafTypes.Description() + " Default value is " +
+					"`string`.",
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.String{Value: "string"}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					treeLeafTypes.Validator(),
+				},
+			},
+			"output_format": {
+				Description: "The format in which `result` is encoded. " + treeOutputFormats.Description() +
+					" Default value is `json`.",
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					planmodifiers.DefaultValue(types.String{Value: "json"}),
+					planmodifiers.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					treeOutputFormats.Validator(),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile trees.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same tree " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Type:     types.StringType,
+				Optional: true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"salt": saltAttribute(),
+			"result": {
+				Description: "The generated tree, encoded in `output_format`. Use `jsondecode` or `yamldecode` " +
+					"to access it.",
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"id": {
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (r *treeResourceType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return &treeResource{
+		source: p.(*provider).source,
+	}, nil
+}
+
+var _ tfsdk.Resource = (*treeResource)(nil)
+
+type treeResource struct {
+	source *randSource
+}
+
+func (r *treeResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan treeModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := random.TreeParams{
+		MaxDepth:   plan.MaxDepth.Value,
+		MaxBreadth: plan.MaxBreadth.Value,
+		LeafType:   plan.LeafType.Value,
+	}
+
+	if random.TreeNodeLimit(params.MaxDepth, params.MaxBreadth, random.MaxTreeNodes) > random.MaxTreeNodes {
+		resp.Diagnostics.AddError(
+			"Create Random Tree Error",
+			fmt.Sprintf("The largest possible tree for max_depth %d and max_breadth %d has more than %d nodes. ",
+				params.MaxDepth, params.MaxBreadth, random.MaxTreeNodes)+
+				"Reduce max_depth or max_breadth.",
+		)
+		return
+	}
+
+	tree, err := random.CreateTree(r.source.newRand(plan.Seed.Value, plan.Salt.Value), params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Tree Error",
+			"The tree could not be generated.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	var result string
+
+	switch plan.OutputFormat.Value {
+	case "yaml":
+		result = random.EncodeTreeYAML(tree)
+	case "toml":
+		result = random.EncodeTreeTOML(tree)
+	default:
+		b, err := json.Marshal(tree)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Create Random Tree Error",
+				"The tree could not be encoded as JSON.\n\n"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+
+		result = string(b)
+	}
+
+	t := treeModelV0{
+		ID:           staticID(),
+		Keepers:      plan.Keepers,
+		MaxDepth:     plan.MaxDepth,
+		MaxBreadth:   plan.MaxBreadth,
+		LeafType:     plan.LeafType,
+		OutputFormat: plan.OutputFormat,
+		Seed:         plan.Seed,
+		Salt:         plan.Salt,
+		Result:       types.String{Value: result},
+	}
+
+	diags = resp.State.Set(ctx, t)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *treeResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+}
+
+// Update is intentionally left blank as all required and optional attributes force replacement of the resource
+// through the RequiresReplace AttributePlanModifier.
+func (r *treeResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *treeResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+}
+
+type treeModelV0 struct {
+	ID           types.String `tfsdk:"id"`
+	Keepers      types.Map    `tfsdk:"keepers"`
+	MaxDepth     types.Int64  `tfsdk:"max_depth"`
+	MaxBreadth   types.Int64  `tfsdk:"max_breadth"`
+	LeafType     types.String `tfsdk:"leaf_type"`
+	OutputFormat types.String `tfsdk:"output_format"`
+	Seed         types.String `tfsdk:"seed"`
+	Salt         types.String `tfsdk:"salt"`
+	Result       types.String `tfsdk:"result"`
+}
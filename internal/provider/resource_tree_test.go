@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceTree(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_tree" "tree" {
+							max_depth   = 3
+							max_breadth = 3
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_tree.tree", "leaf_type", "string"),
+					resource.TestCheckResourceAttr("random_tree.tree", "output_format", "json"),
+					resource.TestCheckResourceAttrWith("random_tree.tree", "result", testCheckJSONObject),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceTree_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_tree" "tree" {
+							max_depth   = 2
+							max_breadth = 2
+							leaf_type   = "bool"
+							seed        = "12345"
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_tree.tree", "result", `{"hiehxaan":{"cjsdaiee":true,"vnicatrr":false}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceTree_OutputFormat(t *testing.T) {
+	cases := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{
+			name:     "yaml",
+			format:   "yaml",
+			expected: "hiehxaan:\n  cjsdaiee: true\n  vnicatrr: false\n",
+		},
+		{
+			name:     "toml",
+			format:   "toml",
+			expected: "[hiehxaan]\ncjsdaiee = true\nvnicatrr = false\n",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`resource "random_tree" "tree" {
+									max_depth     = 2
+									max_breadth   = 2
+									leaf_type     = "bool"
+									output_format = %q
+									seed          = "12345"
+								}`, c.format),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("random_tree.tree", "result", c.expected),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccResourceTree_Errors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_tree" "tree" {
+							max_depth   = 0
+							max_breadth = 2
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be at least 1, got: 0`),
+			},
+			{
+				Config: `resource "random_tree" "tree" {
+							max_depth   = 10
+							max_breadth = 10
+						}`,
+				ExpectError: regexp.MustCompile(`.*The largest possible tree for max_depth 10 and max_breadth 10 has more than\n10000 nodes.`),
+			},
+			{
+				Config: `resource "random_tree" "tree" {
+							max_depth   = 2
+							max_breadth = 2
+							leaf_type   = "list"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be one of:.*got: "list"`),
+			},
+			{
+				Config: `resource "random_tree" "tree" {
+							max_depth     = 2
+							max_breadth   = 2
+							output_format = "xml"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be one of:.*got: "xml"`),
+			},
+		},
+	})
+}
+
+func testCheckJSONObject(input string) error {
+	var v map[string]interface{}
+
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		return fmt.Errorf("expected a JSON object: %w", err)
+	}
+
+	return nil
+}
@@ -3,17 +3,27 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"time"
 
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
+// uuidMaxResultCount is the maximum value of result_count, which bounds the number of UUIDs generated during apply.
+const uuidMaxResultCount = 10000
+
 var _ tfsdk.ResourceType = (*uuidResourceType)(nil)
 
+var uuidVersions = stringEnum{"4", "7"}
+
 type uuidResourceType struct{}
 
 func (r *uuidResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -35,11 +45,57 @@ func (r *uuidResourceType) GetSchema(context.Context) (tfsdk.Schema, diag.Diagno
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"version": {
+				Description: "The UUID version to generate: `4` is random and `7` is time-ordered. " +
+					uuidVersions.Description() + " Defaults to `4`.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					uuidVersions.Validator(),
+				},
+			},
+			"seed": {
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce reproducible UUIDs, e.g. for tests. The UUIDs are still valid version 4 UUIDs. " +
+					"Cannot be used with `version` set to `7`, as version 7 UUIDs contain the time at which " +
+					"they are generated.\n" +
+					"\n" +
+					"**Important:** A seeded UUID is drawn from a random number generator that is not " +
+					"cryptographically secure, and anyone who knows the seed can reproduce it. Only use a seed " +
+					"when the UUID does not need to be unguessable. Even with an identical seed, it is not " +
+					"guaranteed that the same UUID will be produced across different versions of Terraform.",
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+			},
+			"salt": saltAttribute(),
+			"result_count": {
+				Description: "The number of UUIDs to generate into `results`. When set, `results` holds " +
+					"`result` followed by `result_count - 1` further UUIDs. With `version` set to `7`, " +
+					"each UUID in `results` is strictly greater than the one before it. This ordering only " +
+					"holds within the `results` of a single resource, not across resources. Must be " +
+					fmt.Sprintf("between `1` and `%d`.", uuidMaxResultCount),
+				Type:          types.Int64Type,
+				Optional:      true,
+				PlanModifiers: []tfsdk.AttributePlanModifier{tfsdk.RequiresReplace()},
+				Validators: []tfsdk.AttributeValidator{
+					int64validator.Between(1, uuidMaxResultCount),
+				},
+			},
 			"result": {
 				Description: "The generated uuid presented in string format.",
 				Type:        types.StringType,
 				Computed:    true,
 			},
+			"results": {
+				Description: "The `result_count` generated uuids, starting with `result`. Only set when " +
+					"`result_count` is set.",
+				Type: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed: true,
+			},
 			"id": {
 				Description: "The generated uuid presented in string format.",
 				Type:        types.StringType,
@@ -62,29 +118,86 @@ type uuidResource struct {
 }
 
 func (r *uuidResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
-	result, err := uuid.GenerateUUID()
-	if err != nil {
+	var plan uuidModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	count := int64(1)
+	if !plan.ResultCount.Null {
+		count = plan.ResultCount.Value
+	}
+
+	if !plan.Seed.Null && plan.Version.Value == "7" {
 		resp.Diagnostics.AddError(
 			"Create Random UUID error",
-			"There was an error during generation of a UUID.\n\n"+
-				diagnostics.RetryMsg+
-				fmt.Sprintf("Original Error: %s", err),
+			"The seed argument cannot be used when version is 7, as version 7 UUIDs contain the time at which "+
+				"they are generated.",
 		)
 		return
 	}
 
-	var plan uuidModelV0
+	// A single generator is shared by all results so that version 7 UUIDs are strictly increasing.
+	var generator random.UUIDv7Generator
 
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	var seeded *rand.Rand
+	if !plan.Seed.Null {
+		seeded = random.NewSaltedRand(plan.Seed.Value, plan.Salt.Value)
+	}
+
+	results := make([]string, 0, count)
+	for int64(len(results)) < count {
+		var result string
+		var err error
+
+		switch {
+		case seeded != nil:
+			result, err = uuid.FormatUUID(random.SeededUUIDv4(seeded))
+		case plan.Version.Value == "7":
+			var bytes []byte
+			bytes, err = generator.Generate(time.Now())
+			if err == nil {
+				result, err = uuid.FormatUUID(bytes)
+			}
+		default:
+			result, err = uuid.GenerateUUID()
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Create Random UUID error",
+				"There was an error during generation of a UUID.\n\n"+
+					diagnostics.RetryMsg+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+
+		results = append(results, result)
 	}
 
 	u := &uuidModelV0{
-		ID:      types.String{Value: result},
-		Result:  types.String{Value: result},
-		Keepers: plan.Keepers,
+		ID:          resultID(results[0]),
+		Result:      types.String{Value: results[0]},
+		Keepers:     plan.Keepers,
+		Version:     plan.Version,
+		Seed:        plan.Seed,
+		Salt:        plan.Salt,
+		ResultCount: plan.ResultCount,
+		Results:     types.List{ElemType: types.StringType, Null: true},
+	}
+
+	if !plan.ResultCount.Null {
+		elems := make([]attr.Value, 0, len(results))
+		for _, v := range results {
+			elems = append(elems, types.String{Value: v})
+		}
+
+		u.Results.Null = false
+		u.Results.Elems = elems
 	}
 
 	diags = resp.State.Set(ctx, u)
@@ -133,9 +246,14 @@ func (r *uuidResource) ImportState(ctx context.Context, req tfsdk.ImportResource
 
 	var state uuidModelV0
 
-	state.ID.Value = result
+	state.ID = resultID(result)
 	state.Result.Value = result
 	state.Keepers.ElemType = types.StringType
+	state.Version.Null = true
+	state.Seed.Null = true
+	state.Salt.Null = true
+	state.ResultCount.Null = true
+	state.Results = types.List{ElemType: types.StringType, Null: true}
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -145,7 +263,12 @@ func (r *uuidResource) ImportState(ctx context.Context, req tfsdk.ImportResource
 }
 
 type uuidModelV0 struct {
-	ID      types.String `tfsdk:"id"`
-	Keepers types.Map    `tfsdk:"keepers"`
-	Result  types.String `tfsdk:"result"`
+	ID          types.String `tfsdk:"id"`
+	Keepers     types.Map    `tfsdk:"keepers"`
+	Version     types.String `tfsdk:"version"`
+	Seed        types.String `tfsdk:"seed"`
+	Salt        types.String `tfsdk:"salt"`
+	ResultCount types.Int64  `tfsdk:"result_count"`
+	Result      types.String `tfsdk:"result"`
+	Results     types.List   `tfsdk:"results"`
 }
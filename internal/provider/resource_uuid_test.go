@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceUUID(t *testing.T) {
@@ -27,6 +29,120 @@ func TestAccResourceUUID(t *testing.T) {
 	})
 }
 
+func TestAccResourceUUID_Version7ResultCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "v7" {
+							version      = "7"
+							result_count = 50
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_uuid.v7", "results.#", "50"),
+					resource.TestCheckResourceAttrPair("random_uuid.v7", "results.0", "random_uuid.v7", "result"),
+					resource.TestMatchResourceAttr("random_uuid.v7", "result", regexp.MustCompile(`^[\da-f]{8}-[\da-f]{4}-7[\da-f]{3}-[89ab][\da-f]{3}-[\da-f]{12}$`)),
+					testCheckUUIDResultsSorted("random_uuid.v7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "seeded" {
+							count = 2
+							seed  = "12345"
+						}
+						resource "random_uuid" "unseeded" {
+							count = 2
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_uuid.seeded.0", "result", "1ae96956-4b34-433e-8d1a-f05fe6923d6d"),
+					resource.TestCheckResourceAttrPair("random_uuid.seeded.0", "result", "random_uuid.seeded.1", "result"),
+					resource.TestMatchResourceAttr("random_uuid.seeded.0", "result", regexp.MustCompile(`^[\da-f]{8}-[\da-f]{4}-4[\da-f]{3}-[89ab][\da-f]{3}-[\da-f]{12}$`)),
+					testAccCheckDistinctResults("random_uuid.unseeded", 2, "result"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_SeedResultCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "seeded" {
+							seed         = "12345"
+							result_count = 3
+						}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("random_uuid.seeded", "results.#", "3"),
+					resource.TestCheckResourceAttrPair("random_uuid.seeded", "results.0", "random_uuid.seeded", "result"),
+					resource.TestCheckResourceAttr("random_uuid.seeded", "results.1", "e7187099-7d38-4f60-955c-325957214c42"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_VersionErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "basic" {
+							version = "1"
+						}`,
+				ExpectError: regexp.MustCompile(`.*Value must be one of:.*got: "1"`),
+			},
+			{
+				Config: `resource "random_uuid" "basic" {
+							version = "7"
+							seed    = "12345"
+						}`,
+				ExpectError: regexp.MustCompile(`.*The seed argument cannot be used when version is 7`),
+			},
+			{
+				Config: `resource "random_uuid" "basic" {
+							result_count = 10001
+						}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`.*Value must be between 1 and 10000, got: 10001`),
+			},
+		},
+	})
+}
+
+func testCheckUUIDResultsSorted(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		attrs := rs.Primary.Attributes
+		for i := 1; i < len(attrs); i++ {
+			current, ok := attrs[fmt.Sprintf("results.%d", i)]
+			if !ok {
+				break
+			}
+
+			previous := attrs[fmt.Sprintf("results.%d", i-1)]
+			if previous >= current {
+				return fmt.Errorf("expected results to be strictly increasing, got %s followed by %s", previous, current)
+			}
+		}
+
+		return nil
+	}
+}
+
 func TestAccResourceUUID_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// saltAttribute returns the schema of the optional salt attribute, which is combined with seed so that resources
+// sharing a seed produce unrelated results.
+func saltAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "Arbitrary string that is hashed together with `seed` before seeding the random number " +
+			"generator, so that resources sharing a `seed` produce unrelated results, e.g. a `seed` taken " +
+			"from an environment name and a `salt` naming the purpose of the resource. The same `seed` and " +
+			"`salt` always produce the same result. Requires `seed`.",
+		Type:     types.StringType,
+		Optional: true,
+		PlanModifiers: []tfsdk.AttributePlanModifier{
+			tfsdk.RequiresReplace(),
+		},
+		Validators: []tfsdk.AttributeValidator{
+			schemavalidator.AlsoRequires(path.MatchRoot("seed")),
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSalt(t *testing.T) {
+	testCases := map[string]struct {
+		resourceType string
+		arguments    string
+		attribute    string
+	}{
+		"integer": {
+			resourceType: "random_integer",
+			arguments:    "min = 1\nmax = 1000000000",
+			attribute:    "result",
+		},
+		"pet": {
+			resourceType: "random_pet",
+			arguments:    "length = 4",
+			attribute:    "id",
+		},
+		"shuffle_indices": {
+			resourceType: "random_shuffle_indices",
+			arguments:    "length = 20",
+			attribute:    "result.0",
+		},
+		"uuid": {
+			resourceType: "random_uuid",
+			arguments:    "",
+			attribute:    "result",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			address := testCase.resourceType + ".test"
+			config := func(salt string) string {
+				return fmt.Sprintf(`resource %q "unsalted" {
+							%s
+							seed = "12345"
+						}
+
+						resource %q "test" {
+							%s
+							seed = "12345"
+							salt = %q
+						}`, testCase.resourceType, testCase.arguments, testCase.resourceType, testCase.arguments, salt)
+			}
+
+			var unsalted, result string
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config: config("blue"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(address, "salt", "blue"),
+							testAccCheckAttrCapture(testCase.resourceType+".unsalted", testCase.attribute, &unsalted),
+							testAccCheckAttrChanged(address, testCase.attribute, &unsalted),
+							testAccCheckAttrCapture(address, testCase.attribute, &result),
+						),
+					},
+					{
+						Config: config("blue"),
+						Taint:  []string{address},
+						Check:  testAccCheckAttrEquals(address, testCase.attribute, &result),
+					},
+					{
+						Config: config("green"),
+						Check:  testAccCheckAttrChanged(address, testCase.attribute, &result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccResourceSalt_RequiresSeed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							salt = "blue"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "seed" must be specified when "salt" is specified`),
+			},
+		},
+	})
+}
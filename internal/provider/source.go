@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"math/rand"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+const (
+	sourceCrypto = "crypto"
+	sourceMath   = "math"
+)
+
+// sourceKinds are the valid values of the default_source argument of the provider.
+var sourceKinds = stringEnum{sourceCrypto, sourceMath}
+
+// randSource creates the random number generators of the resources that draw from math/rand, following the
+// default_source argument of the provider. It is shared by the provider and its resources, so that the source set
+// by Configure is used by every resource created afterwards.
+type randSource struct {
+	crypto bool
+}
+
+// newRand returns a generator seeded from seed and salt like random.NewSaltedRand. Without a seed, the generator
+// draws from crypto/rand when default_source is crypto, and is seeded with the current time otherwise.
+func (s *randSource) newRand(seed, salt string) *rand.Rand {
+	if seed == "" && s.crypto {
+		return random.NewCryptoRand()
+	}
+
+	return random.NewSaltedRand(seed, salt)
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestAccProvider_DefaultSource(t *testing.T) {
+	for _, source := range sourceKinds {
+		source := source
+
+		t.Run(source, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						// A seed overrides default_source, so that the seeded pet name is the same for every source.
+						Config: fmt.Sprintf(`provider "random" {
+									default_source = %q
+								}
+								resource "random_pet" "seeded" {
+									seed = "12345"
+								}
+								resource "random_integer" "unseeded" {
+									min = 1
+									max = 6
+								}`, source),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("random_pet.seeded", "id", "together-bullfrog"),
+							resource.TestMatchResourceAttr("random_integer.unseeded", "result", regexp.MustCompile(`^[1-6]$`)),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccProvider_DefaultSourceErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							default_source = "time"
+						}
+						resource "random_pet" "pet" {}`,
+				ExpectError: regexp.MustCompile(`.*Value must be one of: \["\\"crypto\\"" "\\"math\\""\], got: "time"`),
+			},
+		},
+	})
+}
+
+func TestProvider_ConfigureDefaultSource(t *testing.T) {
+	testCases := map[string]struct {
+		defaultSource  tftypes.Value
+		expectedCrypto bool
+	}{
+		"unset": {
+			defaultSource: tftypes.NewValue(tftypes.String, nil),
+		},
+		"crypto": {
+			defaultSource:  tftypes.NewValue(tftypes.String, "crypto"),
+			expectedCrypto: true,
+		},
+		"math": {
+			defaultSource: tftypes.NewValue(tftypes.String, "math"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			p := New().(*provider)
+
+			schema, diags := p.GetSchema(ctx)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			req := tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Schema: schema,
+					Raw: tftypes.NewValue(schema.TerraformType(ctx), map[string]tftypes.Value{
+						"default_source": testCase.defaultSource,
+					}),
+				},
+			}
+			resp := tfsdk.ConfigureProviderResponse{}
+
+			p.Configure(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if p.source.crypto != testCase.expectedCrypto {
+				t.Errorf("expected crypto to be %t, got %t", testCase.expectedCrypto, p.source.crypto)
+			}
+		})
+	}
+}
+
+func TestRandSource(t *testing.T) {
+	testCases := map[string]struct {
+		crypto         bool
+		seed           string
+		expectedCrypto bool
+	}{
+		"math":          {},
+		"math seeded":   {seed: "12345"},
+		"crypto":        {crypto: true, expectedCrypto: true},
+		"crypto seeded": {crypto: true, seed: "12345"},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			s := &randSource{crypto: testCase.crypto}
+
+			if testCase.seed != "" {
+				expected := random.NewSaltedRand(testCase.seed, "blue").Int63()
+				if got := s.newRand(testCase.seed, "blue").Int63(); got != expected {
+					t.Errorf("expected the seeded value %d, got %d", expected, got)
+				}
+				return
+			}
+
+			// Re-seeding has no effect on a generator backed by crypto/rand, so only the generators seeded with the
+			// current time produce the same values after being seeded alike.
+			a, b := s.newRand("", ""), s.newRand("", "")
+			a.Seed(1)
+			b.Seed(1)
+
+			if same := a.Int63() == b.Int63() && a.Int63() == b.Int63(); same == testCase.expectedCrypto {
+				t.Errorf("expected drawing from crypto/rand to be %t", testCase.expectedCrypto)
+			}
+		})
+	}
+}
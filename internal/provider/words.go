@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// WordListProvider supplies the word lists that random_pet draws names from when no word_pools are configured.
+//
+// The built-in lists are used by New. A build of the provider that embeds its own lists, e.g. an organization's
+// approved words, passes its implementation to NewWithWordLists when serving the provider:
+//
+//	providerserver.Serve(ctx, func() tfsdk.Provider {
+//		return provider.NewWithWordLists(approvedWords{})
+//	}, opts)
+//
+// Every list returned by PetWords must contain at least one word.
+type WordListProvider interface {
+	PetWords() random.PetWords
+}
+
+// defaultWordLists is the WordListProvider supplying the built-in word lists.
+type defaultWordLists struct{}
+
+func (defaultWordLists) PetWords() random.PetWords {
+	return random.DefaultPetWords()
+}
@@ -0,0 +1,12 @@
+package random
+
+import (
+	"errors"
+)
+
+// MaxAttempts bounds the number of times a value is re-drawn after being rejected, for instance because it
+// appears in an exclusion list, before generation gives up.
+const MaxAttempts = 1000
+
+// ErrMaxAttempts is returned when no acceptable value could be generated within MaxAttempts draws.
+var ErrMaxAttempts = errors.New("unable to generate an acceptable value within the maximum number of attempts")
@@ -0,0 +1,89 @@
+package random
+
+import (
+	"sort"
+	"strings"
+)
+
+var charsetClasses = map[string]string{
+	"alnum":  upperChars + lowerChars + numChars,
+	"alpha":  upperChars + lowerChars,
+	"digit":  numChars,
+	"lower":  lowerChars,
+	"upper":  upperChars,
+	"punct":  "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~",
+	"xdigit": numChars + "ABCDEFabcdef",
+}
+
+// ParseCharsetSpec parses a character set specification into the set of characters it describes, in ascending
+// order and without duplicates.
+//
+// A specification is a sequence of POSIX-like class names, e.g. "[:alnum:]", character ranges, e.g. "a-z", and
+// individual characters. A "-" that does not sit between two characters is taken literally. Only printable ASCII
+// characters are supported.
+func ParseCharsetSpec(spec string) (string, error) {
+	set := make(map[byte]struct{})
+
+	for i := 0; i < len(spec); {
+		if strings.HasPrefix(spec[i:], "[:") {
+			end := strings.Index(spec[i+2:], ":]")
+			if end == -1 {
+				return "", errorf(ErrInvalidParams, "unterminated character class at position %d", i)
+			}
+
+			name := spec[i+2 : i+2+end]
+			chars, ok := charsetClasses[name]
+			if !ok {
+				return "", errorf(ErrInvalidParams, "unknown character class %q", name)
+			}
+
+			for j := 0; j < len(chars); j++ {
+				set[chars[j]] = struct{}{}
+			}
+
+			i += end + 4
+			continue
+		}
+
+		c := spec[i]
+		if c < ' ' || c > '~' {
+			return "", errorf(ErrInvalidParams, "unsupported character %q at position %d, only printable ASCII characters are supported", c, i)
+		}
+
+		if i+2 < len(spec) && spec[i+1] == '-' && !strings.HasPrefix(spec[i+2:], "[:") {
+			last := spec[i+2]
+			if last < ' ' || last > '~' {
+				return "", errorf(ErrInvalidParams, "unsupported character %q at position %d, only printable ASCII characters are supported", last, i+2)
+			}
+
+			if last < c {
+				return "", errorf(ErrInvalidParams, "invalid range %q, the end of a range must not come before its start", spec[i:i+3])
+			}
+
+			for r := c; r <= last; r++ {
+				set[r] = struct{}{}
+			}
+
+			i += 3
+			continue
+		}
+
+		set[c] = struct{}{}
+		i++
+	}
+
+	if len(set) == 0 {
+		return "", newError(ErrRangeEmpty, "the character set is empty")
+	}
+
+	chars := make([]byte, 0, len(set))
+	for c := range set {
+		chars = append(chars, c)
+	}
+
+	sort.Slice(chars, func(i, j int) bool {
+		return chars[i] < chars[j]
+	})
+
+	return string(chars), nil
+}
@@ -0,0 +1,91 @@
+package random
+
+import (
+	"testing"
+)
+
+func TestParseCharsetSpec(t *testing.T) {
+	cases := []struct {
+		name        string
+		spec        string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "class",
+			spec:     "[:digit:]",
+			expected: "0123456789",
+		},
+		{
+			name:     "classes",
+			spec:     "[:xdigit:][:upper:]",
+			expected: "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdef",
+		},
+		{
+			name:     "ranges",
+			spec:     "a-f0-3",
+			expected: "0123abcdef",
+		},
+		{
+			name:     "literals and duplicates",
+			spec:     "zza_",
+			expected: "_az",
+		},
+		{
+			name:     "literal dash",
+			spec:     "-a-c-",
+			expected: "-abc",
+		},
+		{
+			name:     "range and class",
+			spec:     "x-z[:digit:]",
+			expected: "0123456789xyz",
+		},
+		{
+			name:        "empty",
+			spec:        "",
+			expectedErr: true,
+		},
+		{
+			name:        "unknown class",
+			spec:        "[:emoji:]",
+			expectedErr: true,
+		},
+		{
+			name:        "unterminated class",
+			spec:        "[:alpha",
+			expectedErr: true,
+		},
+		{
+			name:        "reversed range",
+			spec:        "z-a",
+			expectedErr: true,
+		},
+		{
+			name:        "non-ascii",
+			spec:        "aé",
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := ParseCharsetSpec(c.spec)
+
+			if c.expectedErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", actual)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package random
+
+var (
+	verhoeffMultiplication = [10][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+		{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+		{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+		{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+		{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+		{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+		{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+		{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+	}
+
+	verhoeffPermutation = [8][10]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+		{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+		{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+		{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+		{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+		{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+		{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+	}
+
+	verhoeffInverse = [10]int{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+)
+
+// LuhnCheckDigit returns the Luhn (mod 10) check digit to append to the given string of decimal digits.
+func LuhnCheckDigit(digits string) (byte, error) {
+	sum := 0
+
+	for i := 0; i < len(digits); i++ {
+		d, err := decimalDigit(digits, len(digits)-1-i)
+		if err != nil {
+			return 0, err
+		}
+
+		// The rightmost digit is doubled, as it becomes the second digit from the right once the check
+		// digit is appended.
+		if i%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+	}
+
+	return byte('0' + (10-sum%10)%10), nil
+}
+
+// VerhoeffCheckDigit returns the Verhoeff check digit to append to the given string of decimal digits.
+func VerhoeffCheckDigit(digits string) (byte, error) {
+	c := 0
+
+	for i := 0; i < len(digits); i++ {
+		d, err := decimalDigit(digits, len(digits)-1-i)
+		if err != nil {
+			return 0, err
+		}
+
+		c = verhoeffMultiplication[c][verhoeffPermutation[(i+1)%8][d]]
+	}
+
+	return byte('0' + verhoeffInverse[c]), nil
+}
+
+func decimalDigit(digits string, i int) (int, error) {
+	if digits[i] < '0' || digits[i] > '9' {
+		return 0, errorf(ErrInvalidParams, "%q is not a decimal digit", digits[i])
+	}
+
+	return int(digits[i] - '0'), nil
+}
@@ -0,0 +1,73 @@
+package random
+
+import (
+	"testing"
+)
+
+func TestCheckDigit(t *testing.T) {
+	cases := []struct {
+		name        string
+		fn          func(string) (byte, error)
+		digits      string
+		expected    byte
+		expectedErr bool
+	}{
+		{
+			name:     "luhn",
+			fn:       LuhnCheckDigit,
+			digits:   "7992739871",
+			expected: '3',
+		},
+		{
+			name:     "luhn zero",
+			fn:       LuhnCheckDigit,
+			digits:   "0",
+			expected: '0',
+		},
+		{
+			name:        "luhn invalid",
+			fn:          LuhnCheckDigit,
+			digits:      "12a",
+			expectedErr: true,
+		},
+		{
+			name:     "verhoeff",
+			fn:       VerhoeffCheckDigit,
+			digits:   "236",
+			expected: '3',
+		},
+		{
+			name:     "verhoeff long",
+			fn:       VerhoeffCheckDigit,
+			digits:   "12345",
+			expected: '1',
+		},
+		{
+			name:        "verhoeff invalid",
+			fn:          VerhoeffCheckDigit,
+			digits:      "-1",
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := c.fn(c.digits)
+
+			if c.expectedErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", actual)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package random
+
+// ChiSquareUniform returns Pearson's chi-square statistic for samples against a uniform
+// distribution over the given number of buckets. Every sample must be a bucket index in the
+// range [0, buckets).
+//
+// It is intended for tests asserting that generated values are approximately uniform: for a
+// uniform source, the statistic follows a chi-square distribution with buckets - 1 degrees of
+// freedom, so it should rarely exceed the critical value for that many degrees of freedom.
+func ChiSquareUniform(samples []int, buckets int) float64 {
+	observed := make([]int, buckets)
+	for _, s := range samples {
+		observed[s]++
+	}
+
+	expected := float64(len(samples)) / float64(buckets)
+
+	var statistic float64
+	for _, o := range observed {
+		d := float64(o) - expected
+		statistic += d * d / expected
+	}
+
+	return statistic
+}
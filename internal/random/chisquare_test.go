@@ -0,0 +1,44 @@
+package random
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChiSquareUniform(t *testing.T) {
+	cases := []struct {
+		name     string
+		samples  []int
+		buckets  int
+		expected float64
+	}{
+		{
+			name:     "uniform",
+			samples:  []int{0, 1, 2, 3, 0, 1, 2, 3},
+			buckets:  4,
+			expected: 0,
+		},
+		{
+			name:     "skewed",
+			samples:  []int{0, 0, 0, 1},
+			buckets:  2,
+			expected: 1,
+		},
+		{
+			name:     "empty bucket",
+			samples:  []int{0, 0, 1, 1},
+			buckets:  3,
+			expected: 2,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := ChiSquareUniform(c.samples, c.buckets)
+
+			if math.Abs(actual-c.expected) > 1e-9 {
+				t.Errorf("expected %f, got %f", c.expected, actual)
+			}
+		})
+	}
+}
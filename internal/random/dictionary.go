@@ -0,0 +1,21 @@
+package random
+
+// DictionaryWords returns the built-in list of common English words and password fragments that generated strings
+// can be checked against with StringParams.ExcludeWords. Every word is lowercase and at least four letters long, so
+// that excluding them rejects only a small share of random strings.
+func DictionaryWords() []string {
+	return append([]string(nil), dictionaryWords...)
+}
+
+var dictionaryWords = []string{
+	"able", "about", "admin", "after", "again", "also", "angel", "back", "bank", "base", "bear", "best", "bird",
+	"blue", "body", "book", "both", "call", "came", "care", "cash", "city", "cold", "come", "cool", "dark", "data",
+	"dead", "dear", "door", "down", "dragon", "each", "even", "ever", "face", "fact", "fire", "fish", "food", "free",
+	"from", "game", "girl", "give", "gold", "good", "hand", "have", "head", "hell", "help", "here", "high",
+	"home", "hope", "into", "just", "keep", "kill", "kind", "king", "know", "last", "life", "like", "line", "live",
+	"login", "long", "look", "lord", "love", "made", "make", "many", "master", "mind", "money", "more", "most", "much",
+	"must", "name", "need", "next", "only", "open", "over", "pass", "play", "queen", "qwerty", "real", "root", "said",
+	"same", "secret", "show", "some", "star", "such", "sure", "take", "team", "tell", "test", "than",
+	"that", "them", "then", "there", "they", "this", "time", "user", "very", "want", "well", "were", "what", "when",
+	"which", "will", "with", "word", "work", "year", "your",
+}
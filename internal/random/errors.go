@@ -0,0 +1,45 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by the functions of this package for parameters that no value can be generated from are of one of
+// the following kinds, which can be matched with errors.Is. The message of each error describes the specific
+// problem, while its kind describes what went wrong in general.
+var (
+	// ErrRangeEmpty is the kind of error returned when there are no values to draw from, e.g. an empty character set.
+	ErrRangeEmpty = errors.New("there are no values to draw from")
+
+	// ErrConstraintUnsatisfiable is the kind of error returned when the parameters are valid on their own, but no
+	// value satisfies all of them at once.
+	ErrConstraintUnsatisfiable = errors.New("no value satisfies all constraints")
+
+	// ErrInvalidParams is the kind of error returned when a parameter is malformed or out of bounds.
+	ErrInvalidParams = errors.New("the parameters are invalid")
+)
+
+// kindError is an error of one of the kinds above, with a message describing the specific problem.
+type kindError struct {
+	kind error
+	msg  string
+}
+
+func (e *kindError) Error() string {
+	return e.msg
+}
+
+func (e *kindError) Unwrap() error {
+	return e.kind
+}
+
+// newError returns an error of the given kind with the given message.
+func newError(kind error, msg string) error {
+	return &kindError{kind: kind, msg: msg}
+}
+
+// errorf returns an error of the given kind with a message formatted as by fmt.Sprintf.
+func errorf(kind error, format string, a ...interface{}) error {
+	return newError(kind, fmt.Sprintf(format, a...))
+}
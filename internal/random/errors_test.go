@@ -0,0 +1,100 @@
+package random
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorKinds(t *testing.T) {
+	t.Parallel()
+
+	rand := NewRand("12345")
+
+	testCases := map[string]struct {
+		err  error
+		kind error
+	}{
+		"empty charset spec": {
+			err: func() error {
+				_, err := ParseCharsetSpec("")
+				return err
+			}(),
+			kind: ErrRangeEmpty,
+		},
+		"unknown character class": {
+			err: func() error {
+				_, err := ParseCharsetSpec("[:emoji:]")
+				return err
+			}(),
+			kind: ErrInvalidParams,
+		},
+		"non-decimal check digit input": {
+			err: func() error {
+				_, err := LuhnCheckDigit("12a")
+				return err
+			}(),
+			kind: ErrInvalidParams,
+		},
+		"passphrase without symbols": {
+			err: func() error {
+				_, err := CreateHybridPassphrase(HybridPassphraseWords(), HybridPassphraseParams{WordCount: 3})
+				return err
+			}(),
+			kind: ErrRangeEmpty,
+		},
+		"word pools without weight": {
+			err: func() error {
+				_, err := CreatePooledPetName(rand, []PetWordPool{{Weight: 0, Words: []string{"lemur"}}}, 2, "-")
+				return err
+			}(),
+			kind: ErrRangeEmpty,
+		},
+		"negative word pool weight": {
+			err: func() error {
+				_, err := CreatePooledPetName(rand, []PetWordPool{{Weight: -1, Words: []string{"lemur"}}}, 2, "-")
+				return err
+			}(),
+			kind: ErrInvalidParams,
+		},
+		"no letter to start with": {
+			err: func() error {
+				_, err := CreateString(StringParams{Length: 4, Numeric: true, MustStartWithLetter: true})
+				return err
+			}(),
+			kind: ErrConstraintUnsatisfiable,
+		},
+		"tree without depth": {
+			err: func() error {
+				_, err := CreateTree(rand, TreeParams{MaxDepth: 0, MaxBreadth: 1, LeafType: "string"})
+				return err
+			}(),
+			kind: ErrInvalidParams,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if !errors.Is(testCase.err, testCase.kind) {
+				t.Errorf("expected an error of kind %q, got %v", testCase.kind, testCase.err)
+			}
+		})
+	}
+}
+
+func TestErrorKinds_Message(t *testing.T) {
+	t.Parallel()
+
+	err := errorf(ErrInvalidParams, "unknown character class %q", "emoji")
+
+	if got, want := err.Error(), `unknown character class "emoji"`; got != want {
+		t.Errorf("expected message %q, got %q", want, got)
+	}
+
+	if errors.Is(err, ErrRangeEmpty) {
+		t.Errorf("expected error not to be of kind %q", ErrRangeEmpty)
+	}
+}
@@ -0,0 +1,50 @@
+package random
+
+// keyboardRows are the rows of a US QWERTY keyboard, first unshifted and then shifted, so that every character
+// typed with the same key is at the same column of its row.
+var keyboardRows = [][2]string{
+	{"`1234567890-=", "~!@#$%^&*()_+"},
+	{"qwertyuiop[]\\", "QWERTYUIOP{}|"},
+	{"asdfghjkl;'", "ASDFGHJKL:\""},
+	{"zxcvbnm,./", "ZXCVBNM<>?"},
+}
+
+// keyboardKey is the position of the key typing a character.
+type keyboardKey struct {
+	row, column int
+}
+
+// keyboardKeys maps every character of keyboardRows onto the position of its key.
+var keyboardKeys = func() map[byte]keyboardKey {
+	keys := make(map[byte]keyboardKey)
+
+	for row, chars := range keyboardRows {
+		for _, s := range chars {
+			for column := 0; column < len(s); column++ {
+				keys[s[column]] = keyboardKey{row: row, column: column}
+			}
+		}
+	}
+
+	return keys
+}()
+
+// isKeyboardPair reports whether b is typed with the key to the right (1) or to the left (-1) of the key typing a,
+// ignoring shift, e.g. 1 for "qw" and "qW", and -1 for "@!". Only keys of the same row are adjacent, so that "1q"
+// is 0.
+func isKeyboardPair(a, b byte) int {
+	ka, oka := keyboardKeys[a]
+	kb, okb := keyboardKeys[b]
+	if !oka || !okb || ka.row != kb.row {
+		return 0
+	}
+
+	switch kb.column - ka.column {
+	case 1:
+		return 1
+	case -1:
+		return -1
+	}
+
+	return 0
+}
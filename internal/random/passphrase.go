@@ -0,0 +1,113 @@
+package random
+
+import (
+	"crypto/rand"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// HybridPassphraseParams describes a passphrase made up of capitalized words followed by a single
+// digit and a single symbol, e.g. "Tiger-Maple-7!".
+type HybridPassphraseParams struct {
+	WordCount int64
+	Separator string
+	Symbols   string
+}
+
+// HybridPassphraseWords returns the words that hybrid passphrases are drawn from: the adjectives
+// and names used for pet names, with duplicates removed.
+func HybridPassphraseWords() []string {
+	words := make([]string, 0, len(petAdjectives)+len(petNames))
+	seen := make(map[string]struct{}, cap(words))
+
+	for _, list := range [][]string{petAdjectives, petNames} {
+		for _, w := range list {
+			if _, ok := seen[w]; ok {
+				continue
+			}
+
+			seen[w] = struct{}{}
+			words = append(words, w)
+		}
+	}
+
+	return words
+}
+
+// CreateHybridPassphrase returns a passphrase of input.WordCount capitalized words drawn from
+// words, followed by a digit and a symbol drawn from input.Symbols, all joined by
+// input.Separator. Every draw uses a cryptographic random number generator.
+func CreateHybridPassphrase(words []string, input HybridPassphraseParams) ([]byte, error) {
+	if input.WordCount < 1 {
+		return nil, newError(ErrInvalidParams, "the passphrase needs to contain at least one word")
+	}
+
+	symbols := distinctChars(input.Symbols)
+	if len(symbols) == 0 {
+		return nil, newError(ErrRangeEmpty, "the symbol set needs to contain at least one character")
+	}
+
+	parts := make([]string, 0, input.WordCount+1)
+
+	for i := int64(0); i < input.WordCount; i++ {
+		idx, err := randomIndex(len(words))
+		if err != nil {
+			return nil, err
+		}
+
+		w := words[idx]
+		parts = append(parts, strings.ToUpper(w[:1])+w[1:])
+	}
+
+	digit, err := randomIndex(len(numChars))
+	if err != nil {
+		return nil, err
+	}
+
+	symbol, err := randomIndex(len(symbols))
+	if err != nil {
+		return nil, err
+	}
+
+	parts = append(parts, string(numChars[digit])+string(symbols[symbol]))
+
+	return []byte(strings.Join(parts, input.Separator)), nil
+}
+
+// HybridPassphraseEntropy returns the entropy, in bits, of the words and of the digit and symbol
+// suffix of a passphrase generated by CreateHybridPassphrase.
+func HybridPassphraseEntropy(words []string, input HybridPassphraseParams) (wordBits, suffixBits float64) {
+	wordBits = float64(input.WordCount) * math.Log2(float64(len(words)))
+	suffixBits = math.Log2(float64(len(numChars))) + math.Log2(float64(len(distinctChars(input.Symbols))))
+
+	return wordBits, suffixBits
+}
+
+// randomIndex returns a uniformly drawn index in [0, n).
+func randomIndex(n int) (int, error) {
+	idx, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(idx.Int64()), nil
+}
+
+// distinctChars returns the characters of s with duplicates removed, so that every character is
+// equally likely to be drawn.
+func distinctChars(s string) []rune {
+	chars := make([]rune, 0, len(s))
+	seen := make(map[rune]struct{}, len(s))
+
+	for _, r := range s {
+		if _, ok := seen[r]; ok {
+			continue
+		}
+
+		seen[r] = struct{}{}
+		chars = append(chars, r)
+	}
+
+	return chars
+}
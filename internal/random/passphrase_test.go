@@ -0,0 +1,97 @@
+package random
+
+import (
+	"math"
+	"regexp"
+	"testing"
+)
+
+func TestCreateHybridPassphrase(t *testing.T) {
+	words := []string{"tiger", "maple"}
+
+	cases := []struct {
+		name     string
+		params   HybridPassphraseParams
+		expected *regexp.Regexp
+	}{
+		{
+			name:     "words",
+			params:   HybridPassphraseParams{WordCount: 2, Separator: "-", Symbols: "!"},
+			expected: regexp.MustCompile(`^(Tiger|Maple)-(Tiger|Maple)-[0-9]!$`),
+		},
+		{
+			name:     "single word",
+			params:   HybridPassphraseParams{WordCount: 1, Separator: "", Symbols: "!?"},
+			expected: regexp.MustCompile(`^(Tiger|Maple)[0-9][!?]$`),
+		},
+		{
+			name:     "multi-byte symbol",
+			params:   HybridPassphraseParams{WordCount: 1, Separator: " ", Symbols: "€"},
+			expected: regexp.MustCompile(`^(Tiger|Maple) [0-9]€$`),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := CreateHybridPassphrase(words, c.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !c.expected.Match(actual) {
+				t.Errorf("expected %q to match %s", actual, c.expected)
+			}
+		})
+	}
+}
+
+func TestCreateHybridPassphrase_Errors(t *testing.T) {
+	cases := []struct {
+		name   string
+		params HybridPassphraseParams
+	}{
+		{
+			name:   "no words",
+			params: HybridPassphraseParams{WordCount: 0, Symbols: "!"},
+		},
+		{
+			name:   "no symbols",
+			params: HybridPassphraseParams{WordCount: 2},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := CreateHybridPassphrase(HybridPassphraseWords(), c.params); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestHybridPassphraseEntropy(t *testing.T) {
+	words := make([]string, 1024)
+
+	wordBits, suffixBits := HybridPassphraseEntropy(words, HybridPassphraseParams{WordCount: 3, Symbols: "!!??"})
+
+	if wordBits != 30 {
+		t.Errorf("expected 30 bits for the words, got %g", wordBits)
+	}
+
+	// Duplicate symbols do not add entropy.
+	if expected := math.Log2(20); math.Abs(suffixBits-expected) > 1e-9 {
+		t.Errorf("expected %g bits for the suffix, got %g", expected, suffixBits)
+	}
+}
+
+func TestHybridPassphraseWords(t *testing.T) {
+	seen := make(map[string]bool)
+
+	for _, w := range HybridPassphraseWords() {
+		if seen[w] {
+			t.Errorf("duplicate word %q", w)
+		}
+
+		seen[w] = true
+	}
+}
@@ -0,0 +1,91 @@
+package random
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// PetWords holds the word lists that pet names are drawn from.
+type PetWords struct {
+	Adverbs    []string
+	Adjectives []string
+	Names      []string
+}
+
+// DefaultPetWords returns the built-in word lists used to generate pet names.
+func DefaultPetWords() PetWords {
+	return PetWords{
+		Adverbs:    petAdverbs,
+		Adjectives: petAdjectives,
+		Names:      petNames,
+	}
+}
+
+// CreatePetName returns a pet name made up of the given number of words, joined by separator.
+//
+// A single word is a name, two words are an adjective followed by a name, and any additional
+// words are adverbs preceding the adjective.
+func CreatePetName(rand *rand.Rand, words PetWords, length int64, separator string) string {
+	parts := make([]string, 0, length)
+
+	for i := int64(0); i < length-2; i++ {
+		parts = append(parts, words.Adverbs[rand.Intn(len(words.Adverbs))])
+	}
+
+	if length >= 2 {
+		parts = append(parts, words.Adjectives[rand.Intn(len(words.Adjectives))])
+	}
+
+	parts = append(parts, words.Names[rand.Intn(len(words.Names))])
+
+	return strings.Join(parts, separator)
+}
+
+// PetWordPool is a themed list of words that pet names can be drawn from, chosen in proportion to Weight.
+type PetWordPool struct {
+	Weight int64
+	Words  []string
+}
+
+// CreatePooledPetName returns a pet name made up of the given number of words, joined by separator. Each word is
+// drawn from a pool chosen in proportion to its weight. Pools with a weight of zero are never chosen, and the
+// weights must add up to more than zero.
+func CreatePooledPetName(rand *rand.Rand, pools []PetWordPool, length int64, separator string) (string, error) {
+	var total int64
+	cumulative := make([]int64, len(pools))
+
+	for i, pool := range pools {
+		if pool.Weight < 0 {
+			return "", newError(ErrInvalidParams, "the weight of a word pool must not be negative")
+		}
+		if pool.Weight > 0 && len(pool.Words) == 0 {
+			return "", newError(ErrRangeEmpty, "a word pool with a weight greater than zero needs to contain at least one word")
+		}
+		if total > math.MaxInt64-pool.Weight {
+			return "", newError(ErrInvalidParams, "the sum of the word pool weights needs to fit within a 64-bit integer")
+		}
+
+		total += pool.Weight
+		cumulative[i] = total
+	}
+
+	if total <= 0 {
+		return "", newError(ErrRangeEmpty, "the weights of the word pools need to add up to more than zero")
+	}
+
+	parts := make([]string, 0, length)
+
+	for i := int64(0); i < length; i++ {
+		n := rand.Int63n(total)
+		idx := sort.Search(len(cumulative), func(j int) bool {
+			return cumulative[j] > n
+		})
+
+		words := pools[idx].Words
+		parts = append(parts, words[rand.Intn(len(words))])
+	}
+
+	return strings.Join(parts, separator), nil
+}
@@ -0,0 +1,209 @@
+package random
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCreatePetName(t *testing.T) {
+	words := PetWords{
+		Adverbs:    []string{"quickly"},
+		Adjectives: []string{"happy"},
+		Names:      []string{"otter"},
+	}
+
+	cases := []struct {
+		name      string
+		length    int64
+		separator string
+		expected  string
+	}{
+		{
+			name:      "name",
+			length:    1,
+			separator: "-",
+			expected:  "otter",
+		},
+		{
+			name:      "adjective",
+			length:    2,
+			separator: "-",
+			expected:  "happy-otter",
+		},
+		{
+			name:      "adverbs",
+			length:    4,
+			separator: "_",
+			expected:  "quickly_quickly_happy_otter",
+		},
+		{
+			name:      "empty separator",
+			length:    2,
+			separator: "",
+			expected:  "happyotter",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := CreatePetName(NewRand(""), words, c.length, c.separator)
+
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDefaultPetWords(t *testing.T) {
+	words := DefaultPetWords()
+
+	for name, list := range map[string][]string{
+		"adverbs":    words.Adverbs,
+		"adjectives": words.Adjectives,
+		"names":      words.Names,
+	} {
+		seen := make(map[string]bool, len(list))
+
+		for _, w := range list {
+			if w == "" || strings.ToLower(w) != w {
+				t.Errorf("%s: invalid word %q", name, w)
+			}
+
+			if seen[w] {
+				t.Errorf("%s: duplicate word %q", name, w)
+			}
+
+			seen[w] = true
+		}
+	}
+}
+
+func TestCreatePooledPetName(t *testing.T) {
+	pools := []PetWordPool{
+		{Weight: 3, Words: []string{"comet", "nebula"}},
+		{Weight: 1, Words: []string{"otter"}},
+		{Weight: 0, Words: []string{"never"}},
+	}
+
+	rand := NewRand("12345")
+	counts := make(map[string]int)
+
+	for i := 0; i < 1000; i++ {
+		name, err := CreatePooledPetName(rand, pools, 4, "-")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		parts := strings.Split(name, "-")
+		if len(parts) != 4 {
+			t.Fatalf("expected 4 words, got %q", name)
+		}
+
+		for _, p := range parts {
+			counts[p]++
+		}
+	}
+
+	if counts["never"] != 0 {
+		t.Errorf("expected no words from the zero-weight pool, got %d", counts["never"])
+	}
+
+	// 3000 of the 4000 words are expected to be drawn from the first pool, with a standard deviation of
+	// roughly 27.
+	if themed := counts["comet"] + counts["nebula"]; themed < 2700 || themed > 3300 {
+		t.Errorf("expected roughly 3000 words from the first pool, got %d", themed)
+	}
+}
+
+func TestCreatePooledPetName_Errors(t *testing.T) {
+	cases := []struct {
+		name  string
+		pools []PetWordPool
+	}{
+		{
+			name:  "zero total",
+			pools: []PetWordPool{{Weight: 0, Words: []string{"otter"}}},
+		},
+		{
+			name:  "negative weight",
+			pools: []PetWordPool{{Weight: -1, Words: []string{"otter"}}, {Weight: 2, Words: []string{"comet"}}},
+		},
+		{
+			name:  "empty pool",
+			pools: []PetWordPool{{Weight: 1}},
+		},
+		{
+			name:  "overflowing total",
+			pools: []PetWordPool{
+				{Weight: math.MaxInt64, Words: []string{"otter"}},
+				{Weight: math.MaxInt64, Words: []string{"comet"}},
+				{Weight: 3, Words: []string{"lemur"}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := CreatePooledPetName(NewRand(""), c.pools, 2, "-"); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+// TestCreatePetName_Golden pins the names generated for several seeds, so that a change to the word lists or to
+// the way words are drawn, which would change the names of existing seeded pets, is caught.
+func TestCreatePetName_Golden(t *testing.T) {
+	cases := []struct {
+		seed     string
+		length   int64
+		expected string
+	}{
+		{seed: "12345", length: 1, expected: "penguin"},
+		{seed: "12345", length: 2, expected: "together-bullfrog"},
+		{seed: "12345", length: 3, expected: "endlessly-usable-akita"},
+		{seed: "pet", length: 2, expected: "nice-cardinal"},
+		{seed: "terraform", length: 4, expected: "fairly-likely-prepared-crappie"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.seed, func(t *testing.T) {
+			actual := CreatePetName(NewRand(c.seed), DefaultPetWords(), c.length, "-")
+
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCreatePooledPetName_Golden(t *testing.T) {
+	pools := []PetWordPool{
+		{Weight: 1, Words: []string{"comet", "nebula", "quasar"}},
+		{Weight: 2, Words: []string{"otter", "lemur"}},
+	}
+
+	cases := []struct {
+		seed     string
+		expected string
+	}{
+		{seed: "12345", expected: "lemur-nebula-quasar"},
+		{seed: "pet", expected: "otter-otter-lemur"},
+		{seed: "terraform", expected: "quasar-comet-lemur"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.seed, func(t *testing.T) {
+			actual, err := CreatePooledPetName(NewRand(c.seed), pools, 3, "-")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
@@ -1,20 +1,28 @@
 package random
 
 import (
-	"hash/crc64"
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
 	"math/rand"
+	"strconv"
 	"time"
 )
 
 // NewRand returns a seeded random number generator, using a seed derived
 // from the provided string.
 //
+// A seed string that is a base 10 integer within the range of an int64,
+// e.g. "12345" or "-7", is used as the seed directly. Any other seed string
+// is hashed with 64-bit FNV-1a and the hash is used as the seed. The same
+// seed string therefore always yields the same sequence.
+//
 // If the seed string is empty, the current time is used as a seed.
 func NewRand(seed string) *rand.Rand {
 	var seedInt int64
 	if seed != "" {
-		crcTable := crc64.MakeTable(crc64.ISO)
-		seedInt = int64(crc64.Checksum([]byte(seed), crcTable))
+		seedInt = seedToInt64(seed)
 	} else {
 		seedInt = time.Now().UnixNano()
 	}
@@ -22,3 +30,65 @@ func NewRand(seed string) *rand.Rand {
 	randSource := rand.NewSource(seedInt)
 	return rand.New(randSource)
 }
+
+// NewSaltedRand returns a seeded random number generator like NewRand, but
+// derives the seed from both the seed string and salt, so that the same seed
+// with different salts yields unrelated sequences.
+//
+// The seed string and salt are hashed together with 64-bit FNV-1a, separated
+// by a zero byte. An empty salt leaves the seed untouched, so that
+// NewSaltedRand(seed, "") yields the same sequence as NewRand(seed). If the
+// seed string is empty, the current time is used as a seed and the salt is
+// ignored.
+func NewSaltedRand(seed, salt string) *rand.Rand {
+	if seed == "" || salt == "" {
+		return NewRand(seed)
+	}
+
+	h := fnv.New64a()
+	// Write on a hash.Hash never returns an error.
+	_, _ = h.Write([]byte(seed))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(salt))
+
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+func seedToInt64(seed string) int64 {
+	if i, err := strconv.ParseInt(seed, 10, 64); err == nil {
+		return i
+	}
+
+	h := fnv.New64a()
+	// Write on a hash.Hash never returns an error.
+	_, _ = h.Write([]byte(seed))
+
+	return int64(h.Sum64())
+}
+
+// NewCryptoRand returns a random number generator that draws every value
+// from crypto/rand, so that its sequence cannot be predicted or reproduced.
+// Seeding the returned generator has no effect.
+func NewCryptoRand() *rand.Rand {
+	return rand.New(cryptoSource{})
+}
+
+// cryptoSource is a math/rand source backed by crypto/rand.
+type cryptoSource struct{}
+
+func (s cryptoSource) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+// Uint64 panics when crypto/rand fails, as a math/rand source cannot return
+// an error and falling back to a predictable value would be unsafe.
+func (s cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := cryptorand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("reading from crypto/rand: %s", err))
+	}
+
+	return binary.BigEndian.Uint64(b[:])
+}
+
+func (s cryptoSource) Seed(int64) {}
@@ -0,0 +1,121 @@
+package random
+
+import (
+	"testing"
+)
+
+func TestNewRand(t *testing.T) {
+	cases := []struct {
+		name          string
+		seed          string
+		expectedSeed  int64
+		expectedFirst int64
+	}{
+		{
+			name:          "numeric",
+			seed:          "12345",
+			expectedSeed:  12345,
+			expectedFirst: 7828158075477027098,
+		},
+		{
+			name:          "negative numeric",
+			seed:          "-7",
+			expectedSeed:  -7,
+			expectedFirst: 747107023976529931,
+		},
+		{
+			name:          "zero",
+			seed:          "0",
+			expectedSeed:  0,
+			expectedFirst: 8717895732742165505,
+		},
+		{
+			name:          "non-numeric",
+			seed:          "hello",
+			expectedSeed:  -6615550055289275125,
+			expectedFirst: 5244427217503083389,
+		},
+		{
+			name:          "numeric prefix",
+			seed:          "12345abc",
+			expectedSeed:  5343523994766809416,
+			expectedFirst: 3625803404848197419,
+		},
+		{
+			name:          "out of int64 range",
+			seed:          "9223372036854775808",
+			expectedSeed:  -590260884831411150,
+			expectedFirst: 4296921280604945850,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := seedToInt64(c.seed); actual != c.expectedSeed {
+				t.Errorf("expected seed %d, got %d", c.expectedSeed, actual)
+			}
+
+			if actual := NewRand(c.seed).Int63(); actual != c.expectedFirst {
+				t.Errorf("expected first draw %d, got %d", c.expectedFirst, actual)
+			}
+		})
+	}
+}
+
+func TestNewSaltedRand(t *testing.T) {
+	cases := []struct {
+		name          string
+		seed          string
+		salt          string
+		expectedFirst int64
+	}{
+		{
+			name:          "no salt",
+			seed:          "12345",
+			expectedFirst: 7828158075477027098,
+		},
+		{
+			name:          "salt",
+			seed:          "12345",
+			salt:          "blue",
+			expectedFirst: 848607056877609990,
+		},
+		{
+			name:          "other salt",
+			seed:          "12345",
+			salt:          "green",
+			expectedFirst: 6528915336808751195,
+		},
+		{
+			name:          "salt moved into seed",
+			seed:          "12345blue",
+			expectedFirst: 860745239344481880,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := NewSaltedRand(c.seed, c.salt).Int63(); actual != c.expectedFirst {
+				t.Errorf("expected first draw %d, got %d", c.expectedFirst, actual)
+			}
+		})
+	}
+}
+
+func TestNewCryptoRand(t *testing.T) {
+	a, b := NewCryptoRand(), NewCryptoRand()
+
+	// Seeding a generator backed by crypto/rand has no effect, so equally seeded generators still differ.
+	a.Seed(12345)
+	b.Seed(12345)
+
+	if a.Int63() == b.Int63() && a.Int63() == b.Int63() {
+		t.Errorf("expected equally seeded generators to produce different values")
+	}
+
+	for i := 0; i < 1000; i++ {
+		if n := a.Int63(); n < 0 {
+			t.Fatalf("expected a non-negative value, got %d", n)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package random
+
+import (
+	"math"
+)
+
+// StrengthLabels maps each score returned by Strength to a human-readable label.
+var StrengthLabels = []string{"very weak", "weak", "fair", "strong", "very strong"}
+
+// strengthThresholds holds the minimum estimated entropy, in bits, needed for scores 1 to 4.
+var strengthThresholds = []float64{28, 36, 60, 128}
+
+// Strength returns a heuristic strength score between 0 and 4 for s, similar in spirit to the
+// scores reported by zxcvbn, based on the estimate of the entropy of s returned by EntropyEstimate.
+func Strength(s []byte) int64 {
+	bits := EntropyEstimate(s)
+
+	var score int64
+	for _, threshold := range strengthThresholds {
+		if bits < threshold {
+			break
+		}
+		score++
+	}
+
+	return score
+}
+
+// EntropyEstimate returns a heuristic estimate of the entropy of s in bits.
+//
+// The estimate assumes every character was drawn from the union of the character classes present
+// in s: lowercase letters (26), uppercase letters (26), digits (10) and other printable characters
+// (33). Characters that extend a run of three or more repeated or sequential characters, e.g. the
+// third "a" in "aaa" or the "c" in "abc", are considered guessable and do not contribute to the
+// estimate.
+func EntropyEstimate(s []byte) float64 {
+	var hasLower, hasUpper, hasNumeric, hasOther bool
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z':
+			hasLower = true
+		case c >= 'A' && c <= 'Z':
+			hasUpper = true
+		case c >= '0' && c <= '9':
+			hasNumeric = true
+		default:
+			hasOther = true
+		}
+	}
+
+	var poolSize int
+	if hasLower {
+		poolSize += len(lowerChars)
+	}
+	if hasUpper {
+		poolSize += len(upperChars)
+	}
+	if hasNumeric {
+		poolSize += len(numChars)
+	}
+	if hasOther {
+		poolSize += 33
+	}
+
+	if poolSize < 2 {
+		return 0
+	}
+
+	sequential := runLengths(s, isSequentialPair)
+	repeated := runLengths(s, isRepeatedPair)
+
+	var effective int
+	for i := range s {
+		if sequential[i] < 3 && repeated[i] < 3 {
+			effective++
+		}
+	}
+
+	return float64(effective) * math.Log2(float64(poolSize))
+}
+
+// MaxEntropyEstimate returns the highest estimate that EntropyEstimate can return for a string
+// generated with input, i.e. for a string of input.Length characters containing every enabled
+// character class and no runs of repeated or sequential characters.
+func MaxEntropyEstimate(input StringParams) float64 {
+	var poolSize int
+	if input.Lower {
+		poolSize += len(lowerChars)
+	}
+	if input.Upper {
+		poolSize += len(upperChars)
+	}
+	if input.Numeric {
+		poolSize += len(numChars)
+	}
+	if input.Special && input.SpecialChars() != "" {
+		poolSize += 33
+	}
+
+	if poolSize < 2 {
+		return 0
+	}
+
+	return float64(input.Length) * math.Log2(float64(poolSize))
+}
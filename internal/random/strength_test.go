@@ -0,0 +1,99 @@
+package random
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStrength(t *testing.T) {
+	cases := []struct {
+		name          string
+		input         string
+		expectedScore int64
+	}{
+		{
+			name:          "empty",
+			input:         "",
+			expectedScore: 0,
+		},
+		{
+			name:          "single character class",
+			input:         "qwhz",
+			expectedScore: 0,
+		},
+		{
+			name:          "repeated",
+			input:         "aaaaaaaaaaaaaaaaaaaaaaaa",
+			expectedScore: 0,
+		},
+		{
+			name:          "sequential",
+			input:         "abcdefghijklmnopqrstuvwx",
+			expectedScore: 0,
+		},
+		{
+			name:          "weak",
+			input:         "qwhz93",
+			expectedScore: 1,
+		},
+		{
+			name:          "fair",
+			input:         "Xq7#kP2m!",
+			expectedScore: 2,
+		},
+		{
+			name:          "strong",
+			input:         "DZy_3*tnonj%Q%Yx",
+			expectedScore: 3,
+		},
+		{
+			name:          "very strong",
+			input:         "DZy_3*tnonj%Q%YxDZy_3*tnonj%Q%Yx",
+			expectedScore: 4,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			score := Strength([]byte(c.input))
+
+			if score != c.expectedScore {
+				t.Errorf("expected score %d, got %d", c.expectedScore, score)
+			}
+		})
+	}
+}
+
+func TestMaxEntropyEstimate(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    StringParams
+		expected float64
+	}{
+		{
+			name:     "lower only",
+			input:    StringParams{Length: 16, Lower: true},
+			expected: 75.2,
+		},
+		{
+			name:     "all classes",
+			input:    StringParams{Length: 20, Lower: true, Upper: true, Numeric: true, Special: true},
+			expected: 131.4,
+		},
+		{
+			name:     "no classes",
+			input:    StringParams{Length: 16},
+			expected: 0,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			estimate := math.Round(MaxEntropyEstimate(c.input)*10) / 10
+
+			if estimate != c.expected {
+				t.Errorf("expected estimate %.1f, got %.1f", c.expected, estimate)
+			}
+		})
+	}
+}
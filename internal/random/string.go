@@ -4,6 +4,8 @@ import (
 	"crypto/rand"
 	"math/big"
 	"sort"
+	"strings"
+	"unicode/utf8"
 )
 
 type StringParams struct {
@@ -17,33 +19,361 @@ type StringParams struct {
 	Special         bool
 	MinSpecial      int64
 	OverrideSpecial string
+
+	// Charset, when set, replaces the characters enabled by Upper, Lower, Numeric and Special.
+	// Minimums continue to be drawn from their own classes, so callers combining Charset with a
+	// minimum should ensure the two agree.
+	Charset string
+
+	// Weights, when set, replaces all other character settings: every position is drawn from the
+	// keys of Weights, with a probability proportional to the weight of each character. The
+	// weights must not be negative and must add up to more than zero.
+	Weights map[byte]int64
+
+	// MustStartWithLetter guarantees that the first character of the result is an
+	// uppercase or lowercase letter, drawn from the enabled alphabet classes.
+	MustStartWithLetter bool
+
+	// AlternateCase gives every letter of the result a random case after it is generated. When
+	// neither Charset nor Weights is set, letters are drawn from a single alphabet if Upper or
+	// Lower is enabled, so that letters are not twice as likely as when only one case is enabled.
+	// The case of letters claimed by MinUpper or MinLower is not preserved.
+	AlternateCase bool
+
+	// Exclude lists results that must not be returned. A result that appears in Exclude is
+	// re-drawn, up to MaxAttempts times, after which ErrMaxAttempts is returned.
+	Exclude []string
+
+	// ExcludeSequential rejects results containing more than MaxSequence consecutive
+	// characters that are sequential, ascending or descending, within the digits, the
+	// lowercase or the uppercase alphabet, e.g. "123" or "cba" when MaxSequence is 2.
+	ExcludeSequential bool
+	// ExcludeRepeated rejects results containing more than MaxSequence consecutive
+	// occurrences of the same character. Each character that would extend such a run is first
+	// re-drawn from the characters that differ from it, without taking its class below its
+	// minimum, so that long results can be generated. Results that still contain such a run are
+	// re-drawn like Exclude.
+	ExcludeRepeated bool
+	// ExcludeKeyboard rejects results containing more than MaxSequence consecutive
+	// characters typed with adjacent keys of the same row of a US QWERTY keyboard, left to
+	// right or right to left and ignoring shift, e.g. "qwe" or "#@!" when MaxSequence is 2.
+	// Rejected results are re-drawn like Exclude.
+	ExcludeKeyboard bool
+	MaxSequence     int64
+
+	// ExcludeWords rejects results that contain any of the listed words, ignoring case, e.g.
+	// the words returned by DictionaryWords. Rejected results are re-drawn like Exclude.
+	ExcludeWords []string
+
+	// MinEntropy rejects results for which EntropyEstimate returns fewer bits. Rejected
+	// results are re-drawn like Exclude.
+	MinEntropy float64
 }
 
+const (
+	numChars   = "0123456789"
+	lowerChars = "abcdefghijklmnopqrstuvwxyz"
+	upperChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
 func CreateString(input StringParams) ([]byte, error) {
-	const numChars = "0123456789"
-	const lowerChars = "abcdefghijklmnopqrstuvwxyz"
-	const upperChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	var specialChars = "!@#$%&*()-_=+[]{}<>:?"
-	var result []byte
+	excluded := make(map[string]struct{}, len(input.Exclude))
+	for _, v := range input.Exclude {
+		excluded[v] = struct{}{}
+	}
 
-	if input.OverrideSpecial != "" {
-		specialChars = input.OverrideSpecial
+	words := make([]string, 0, len(input.ExcludeWords))
+	for _, w := range input.ExcludeWords {
+		words = append(words, strings.ToLower(w))
 	}
 
-	var chars = ""
-	if input.Upper {
-		chars += upperChars
+	for i := 0; i < MaxAttempts; i++ {
+		result, err := createString(input)
+		if err != nil {
+			return nil, err
+		}
+
+		if input.AlternateCase {
+			if err := alternateCase(result); err != nil {
+				return nil, err
+			}
+		}
+
+		if input.ExcludeRepeated {
+			if err := input.breakRepeats(result); err != nil {
+				return nil, err
+			}
+		}
+
+		if _, ok := excluded[string(result)]; ok {
+			continue
+		}
+
+		if input.ExcludeSequential && longestRun(result, isSequentialPair) > input.MaxSequence {
+			continue
+		}
+
+		if input.ExcludeRepeated && longestRun(result, isRepeatedPair) > input.MaxSequence {
+			continue
+		}
+
+		if input.ExcludeKeyboard && longestRun(result, isKeyboardPair) > input.MaxSequence {
+			continue
+		}
+
+		if containsAny(strings.ToLower(string(result)), words) {
+			continue
+		}
+
+		if input.MinEntropy > 0 && EntropyEstimate(result) < input.MinEntropy {
+			continue
+		}
+
+		return result, nil
+	}
+
+	return nil, ErrMaxAttempts
+}
+
+// containsAny reports whether s contains any of substrings.
+func containsAny(s string, substrings []string) bool {
+	for _, sub := range substrings {
+		if strings.Contains(s, sub) {
+			return true
+		}
 	}
-	if input.Lower {
-		chars += lowerChars
+
+	return false
+}
+
+// breakRepeats re-draws, in place, every character of s that would extend a run of the same
+// character beyond MaxSequence. Characters for which no replacement is available are left in
+// place.
+func (input StringParams) breakRepeats(s []byte) error {
+	counts := make(map[string]int64, 4)
+	for _, c := range s {
+		class, _ := input.charClass(c)
+		counts[class]++
+	}
+
+	run := int64(1)
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[i-1] {
+			run = 1
+			continue
+		}
+
+		run++
+		if run <= input.MaxSequence {
+			continue
+		}
+
+		c, ok, err := input.drawReplacement(s[i], counts)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		class, _ := input.charClass(s[i])
+		counts[class]--
+		class, _ = input.charClass(c)
+		counts[class]++
+
+		s[i] = c
+		run = 1
+	}
+
+	return nil
+}
+
+// drawReplacement draws a character other than c that c can be replaced with. When Weights is
+// set, the character is drawn in proportion to its weight. Otherwise it is drawn from Chars,
+// or from the class of c alone when counts shows that the class of c would otherwise end up
+// below its minimum. It reports false when there is no such character.
+func (input StringParams) drawReplacement(c byte, counts map[string]int64) (byte, bool, error) {
+	if len(input.Weights) > 0 {
+		weights := make(map[byte]int64, len(input.Weights))
+		for k, weight := range input.Weights {
+			if k != c && weight > 0 {
+				weights[k] = weight
+			}
+		}
+		if len(weights) == 0 {
+			return 0, false, nil
+		}
+
+		drawn, err := createWeightedString(StringParams{Length: 1, Weights: weights})
+		if err != nil {
+			return 0, false, err
+		}
+
+		return drawn[0], true, nil
+	}
+
+	pool := input.Chars()
+	if input.AlternateCase {
+		// Letters have been given a random case, so either case is a valid replacement.
+		pool += strings.ToUpper(pool)
+	}
+
+	if class, min := input.charClass(c); counts[class] <= min {
+		pool = class
+	}
+
+	candidates := strings.Map(func(r rune) rune {
+		if r == rune(c) {
+			return -1
+		}
+		return r
+	}, pool)
+	if candidates == "" {
+		return 0, false, nil
+	}
+
+	drawn, err := generateRandomBytes(&candidates, 1)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return drawn[0], true, nil
+}
+
+// charClass returns the class that c counts towards, i.e. the characters that its minimum is
+// drawn from, along with that minimum. Any character that is not a digit or an ASCII letter
+// counts as special. Letters have no minimum when AlternateCase is set, as their case is not
+// preserved.
+func (input StringParams) charClass(c byte) (string, int64) {
+	switch {
+	case c >= '0' && c <= '9':
+		return numChars, input.MinNumeric
+	case c >= 'a' && c <= 'z' && input.AlternateCase:
+		return lowerChars, 0
+	case c >= 'a' && c <= 'z':
+		return lowerChars, input.MinLower
+	case c >= 'A' && c <= 'Z' && input.AlternateCase:
+		return upperChars, 0
+	case c >= 'A' && c <= 'Z':
+		return upperChars, input.MinUpper
+	default:
+		return input.SpecialChars(), input.MinSpecial
+	}
+}
+
+// Chars returns the characters that are enabled by the Upper, Lower, Numeric and Special
+// settings, i.e. the characters that any position not claimed by a minimum is drawn from.
+// When Charset is set it is returned as-is.
+func (input StringParams) Chars() string {
+	if input.Charset != "" {
+		return input.Charset
+	}
+
+	var chars = ""
+	if input.AlternateCase {
+		if input.Upper || input.Lower {
+			chars += lowerChars
+		}
+	} else {
+		if input.Upper {
+			chars += upperChars
+		}
+		if input.Lower {
+			chars += lowerChars
+		}
 	}
 	if input.Numeric {
 		chars += numChars
 	}
 	if input.Special {
-		chars += specialChars
+		chars += input.SpecialChars()
+	}
+
+	return chars
+}
+
+// RunsAvoidable reports whether a result of Length characters drawn from Chars can avoid every
+// run rejected by ExcludeSequential, ExcludeRepeated and ExcludeKeyboard: either because no run
+// can be longer than MaxSequence, or because two characters, or a single one when repeats are
+// allowed, form no run with each other, so that alternating them forms none. Minimums are not
+// taken into account.
+func (input StringParams) RunsAvoidable() bool {
+	if input.Length <= input.MaxSequence {
+		return true
+	}
+
+	chars := input.Chars()
+	for i := 0; i < len(chars); i++ {
+		for j := i; j < len(chars); j++ {
+			a, b := chars[i], chars[j]
+
+			if input.ExcludeRepeated && isRepeatedPair(a, b) != 0 {
+				continue
+			}
+			if input.ExcludeSequential && isSequentialPair(a, b) != 0 {
+				continue
+			}
+			if input.ExcludeKeyboard && isKeyboardPair(a, b) != 0 {
+				continue
+			}
+
+			return true
+		}
 	}
 
+	return false
+}
+
+// InferStringParams returns the Length, Upper, Lower, Numeric and Special settings that
+// describe s: Length is the number of runes in s, and each class is enabled when s contains at
+// least one of its characters. Any character that is not a digit or an ASCII letter counts as
+// special. The settings that originally produced s may have enabled classes that s happens
+// not to contain.
+func InferStringParams(s string) StringParams {
+	input := StringParams{
+		Length: int64(utf8.RuneCountInString(s)),
+	}
+
+	for _, r := range s {
+		switch {
+		case strings.ContainsRune(upperChars, r):
+			input.Upper = true
+		case strings.ContainsRune(lowerChars, r):
+			input.Lower = true
+		case strings.ContainsRune(numChars, r):
+			input.Numeric = true
+		default:
+			input.Special = true
+		}
+	}
+
+	return input
+}
+
+// SpecialChars returns the characters enabled by Special: OverrideSpecial when it is set, and
+// the default special characters otherwise.
+func (input StringParams) SpecialChars() string {
+	if input.OverrideSpecial != "" {
+		return input.OverrideSpecial
+	}
+
+	return "!@#$%&*()-_=+[]{}<>:?"
+}
+
+func createString(input StringParams) ([]byte, error) {
+	if len(input.Weights) > 0 {
+		return createWeightedString(input)
+	}
+
+	if input.MustStartWithLetter {
+		return createStringStartingWithLetter(input)
+	}
+
+	var result []byte
+
+	specialChars := input.SpecialChars()
+	chars := input.Chars()
+
 	minMapping := map[string]int64{
 		numChars:     input.MinNumeric,
 		lowerChars:   input.MinLower,
@@ -80,6 +410,130 @@ func CreateString(input StringParams) ([]byte, error) {
 	return result, nil
 }
 
+// createStringStartingWithLetter draws the first character from the enabled alphabet classes and
+// generates the remainder of the string with createString. When the first letter belongs to a class
+// with a minimum, it counts towards that minimum so that the remaining positions only need to satisfy
+// what is left over.
+func createStringStartingWithLetter(input StringParams) ([]byte, error) {
+	remaining := input.Length - 1
+	sumMins := input.MinUpper + input.MinLower + input.MinNumeric + input.MinSpecial
+
+	var letters string
+	if remaining >= sumMins {
+		if input.Upper {
+			letters += upperChars
+		}
+		if input.Lower {
+			letters += lowerChars
+		}
+	} else {
+		// Every position is already claimed by a minimum, so the first letter has to be
+		// taken from a class whose minimum it can satisfy.
+		if input.MinUpper > 0 {
+			letters += upperChars
+		}
+		if input.MinLower > 0 {
+			letters += lowerChars
+		}
+	}
+
+	if letters == "" || remaining < 0 {
+		return nil, newError(ErrConstraintUnsatisfiable, "the result cannot start with a letter: no alphabet characters are available for the first position")
+	}
+
+	first, err := generateRandomBytes(&letters, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	rest := input
+	rest.Length = remaining
+	rest.MustStartWithLetter = false
+
+	switch {
+	case first[0] >= 'A' && first[0] <= 'Z' && rest.MinUpper > 0:
+		rest.MinUpper--
+	case first[0] >= 'a' && first[0] <= 'z' && rest.MinLower > 0:
+		rest.MinLower--
+	}
+
+	s, err := createString(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(first, s...), nil
+}
+
+// createWeightedString draws every character of the result from the keys of input.Weights, in
+// proportion to their weights.
+func createWeightedString(input StringParams) ([]byte, error) {
+	chars := make([]byte, 0, len(input.Weights))
+	for c := range input.Weights {
+		chars = append(chars, c)
+	}
+
+	// Characters are sorted so that each draw maps onto the same character regardless of map
+	// iteration order.
+	sort.Slice(chars, func(i, j int) bool {
+		return chars[i] < chars[j]
+	})
+
+	var total int64
+	cumulative := make([]int64, len(chars))
+
+	for i, c := range chars {
+		total += input.Weights[c]
+		cumulative[i] = total
+	}
+
+	if total <= 0 {
+		return nil, newError(ErrRangeEmpty, "the character weights must add up to more than zero")
+	}
+
+	result := make([]byte, input.Length)
+	max := big.NewInt(total)
+
+	for i := range result {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return nil, err
+		}
+
+		idx := sort.Search(len(cumulative), func(j int) bool {
+			return cumulative[j] > n.Int64()
+		})
+		result[i] = chars[idx]
+	}
+
+	return result, nil
+}
+
+// alternateCase randomly changes each letter in s to uppercase or lowercase, in place.
+func alternateCase(s []byte) error {
+	flips := make([]byte, len(s))
+	if _, err := rand.Read(flips); err != nil {
+		return err
+	}
+
+	for i, c := range s {
+		// Setting the 0x20 bit maps uppercase ASCII letters onto lowercase ones, and no other
+		// character onto a lowercase letter.
+		lower := c | 0x20
+		if lower < 'a' || lower > 'z' {
+			continue
+		}
+
+		if flips[i]&1 == 0 {
+			s[i] = lower - ('a' - 'A')
+		} else {
+			s[i] = lower
+		}
+	}
+
+	return nil
+}
+
 func generateRandomBytes(charSet *string, length int64) ([]byte, error) {
 	bytes := make([]byte, length)
 	setLen := big.NewInt(int64(len(*charSet)))
@@ -92,3 +546,73 @@ func generateRandomBytes(charSet *string, length int64) ([]byte, error) {
 	}
 	return bytes, nil
 }
+
+// longestRun returns the length of the longest run of characters in s in which every pair
+// of adjacent characters satisfies the direction reported by pair: 1 for ascending, -1 for
+// descending and 0 for neither. A run of a single character has a length of 1.
+func longestRun(s []byte, pair func(a, b byte) int) int64 {
+	var longest int64
+
+	for _, current := range runLengths(s, pair) {
+		if current > longest {
+			longest = current
+		}
+	}
+
+	return longest
+}
+
+// runLengths returns, for every position in s, the length of the run as defined by longestRun
+// that ends at that position.
+func runLengths(s []byte, pair func(a, b byte) int) []int64 {
+	lengths := make([]int64, len(s))
+
+	var current int64
+	direction := 0
+
+	for i := range s {
+		d := 0
+		if i > 0 {
+			d = pair(s[i-1], s[i])
+		}
+
+		if d != 0 && d == direction {
+			current++
+		} else if d != 0 {
+			current = 2
+		} else {
+			current = 1
+		}
+
+		direction = d
+		lengths[i] = current
+	}
+
+	return lengths
+}
+
+func isSequentialPair(a, b byte) int {
+	for _, class := range []string{numChars, lowerChars, upperChars} {
+		i, j := strings.IndexByte(class, a), strings.IndexByte(class, b)
+		if i == -1 || j == -1 {
+			continue
+		}
+
+		switch j - i {
+		case 1:
+			return 1
+		case -1:
+			return -1
+		}
+	}
+
+	return 0
+}
+
+func isRepeatedPair(a, b byte) int {
+	if a == b {
+		return 1
+	}
+
+	return 0
+}
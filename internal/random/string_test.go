@@ -0,0 +1,351 @@
+package random
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLongestRun(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		pair     func(a, b byte) int
+		expected int64
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			pair:     isSequentialPair,
+			expected: 0,
+		},
+		{
+			name:     "ascending digits",
+			input:    "x1234y",
+			pair:     isSequentialPair,
+			expected: 4,
+		},
+		{
+			name:     "descending letters",
+			input:    "Ecba9",
+			pair:     isSequentialPair,
+			expected: 3,
+		},
+		{
+			name:     "direction change",
+			input:    "abcba",
+			pair:     isSequentialPair,
+			expected: 3,
+		},
+		{
+			name:     "across classes",
+			input:    "9aZA",
+			pair:     isSequentialPair,
+			expected: 1,
+		},
+		{
+			name:     "repeated",
+			input:    "abbbcc",
+			pair:     isRepeatedPair,
+			expected: 3,
+		},
+		{
+			name:     "keyboard row",
+			input:    "1qwerty9",
+			pair:     isKeyboardPair,
+			expected: 6,
+		},
+		{
+			name:     "keyboard ignoring shift",
+			input:    "xaSdF",
+			pair:     isKeyboardPair,
+			expected: 4,
+		},
+		{
+			name:     "keyboard right to left",
+			input:    "#@!a",
+			pair:     isKeyboardPair,
+			expected: 3,
+		},
+		{
+			name:     "keyboard across rows",
+			input:    "1qaz",
+			pair:     isKeyboardPair,
+			expected: 1,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := longestRun([]byte(c.input), c.pair); actual != c.expected {
+				t.Errorf("expected %d, got %d", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCreateString_ExcludeSequentialAndRepeated(t *testing.T) {
+	params := StringParams{
+		Length:            10,
+		Numeric:           true,
+		ExcludeSequential: true,
+		ExcludeRepeated:   true,
+		MaxSequence:       1,
+	}
+
+	for i := 0; i < 20; i++ {
+		result, err := CreateString(params)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if longestRun(result, isSequentialPair) > 1 || longestRun(result, isRepeatedPair) > 1 {
+			t.Fatalf("unexpected run in %q", result)
+		}
+	}
+}
+
+func TestCreateString_ExcludeRepeatedLong(t *testing.T) {
+	cases := map[string]StringParams{
+		"two characters":  {Length: 1000, Charset: "ab"},
+		"numeric minimum": {Length: 1000, Lower: true, Numeric: true, MinNumeric: 400},
+		"weights":         {Length: 1000, Weights: map[byte]int64{'a': 3, 'b': 1}},
+		"alternate case":  {Length: 1000, Lower: true, AlternateCase: true},
+	}
+
+	for name, params := range cases {
+		name, params := name, params
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			params.ExcludeRepeated = true
+			params.MaxSequence = 1
+
+			result, err := CreateString(params)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if int64(len(result)) != params.Length {
+				t.Errorf("expected length %d, got %d", params.Length, len(result))
+			}
+
+			if longestRun(result, isRepeatedPair) > 1 {
+				t.Errorf("unexpected repeated run in %q", result)
+			}
+
+			var numeric int64
+			for _, c := range result {
+				if c >= '0' && c <= '9' {
+					numeric++
+				}
+			}
+
+			if numeric < params.MinNumeric {
+				t.Errorf("expected at least %d digits, got %d", params.MinNumeric, numeric)
+			}
+		})
+	}
+}
+
+func TestCreateString_ExcludeKeyboard(t *testing.T) {
+	params := StringParams{
+		Length:          32,
+		Lower:           true,
+		ExcludeKeyboard: true,
+		MaxSequence:     1,
+	}
+
+	for i := 0; i < 20; i++ {
+		result, err := CreateString(params)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if longestRun(result, isKeyboardPair) > 1 {
+			t.Fatalf("unexpected keyboard sequence in %q", result)
+		}
+	}
+}
+
+func TestStringParamsRunsAvoidable(t *testing.T) {
+	cases := []struct {
+		name     string
+		params   StringParams
+		expected bool
+	}{
+		{
+			name:     "repeats allowed",
+			params:   StringParams{Length: 8, Special: true, OverrideSpecial: "!@", ExcludeKeyboard: true, MaxSequence: 1},
+			expected: true,
+		},
+		{
+			name:     "adjacent keys only",
+			params:   StringParams{Length: 8, Special: true, OverrideSpecial: "!@", ExcludeRepeated: true, ExcludeKeyboard: true, MaxSequence: 1},
+			expected: false,
+		},
+		{
+			name:     "short enough",
+			params:   StringParams{Length: 2, Special: true, OverrideSpecial: "!@", ExcludeRepeated: true, ExcludeKeyboard: true, MaxSequence: 2},
+			expected: true,
+		},
+		{
+			name:     "sequential and adjacent",
+			params:   StringParams{Length: 8, Special: true, OverrideSpecial: "op", ExcludeRepeated: true, ExcludeSequential: true, ExcludeKeyboard: true, MaxSequence: 1},
+			expected: false,
+		},
+		{
+			name:     "non-adjacent pair",
+			params:   StringParams{Length: 8, Special: true, OverrideSpecial: "!#", ExcludeRepeated: true, ExcludeKeyboard: true, MaxSequence: 1},
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := c.params.RunsAvoidable(); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCreateString_ExcludeWords(t *testing.T) {
+	params := StringParams{
+		Length:       8,
+		Charset:      "aB",
+		ExcludeWords: []string{"bb", "ABAB"},
+	}
+
+	for i := 0; i < 20; i++ {
+		result, err := CreateString(params)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		lower := strings.ToLower(string(result))
+		if strings.Contains(lower, "bb") || strings.Contains(lower, "abab") {
+			t.Fatalf("unexpected word in %q", result)
+		}
+	}
+}
+
+func TestCreateString_Weights(t *testing.T) {
+	result, err := CreateString(StringParams{
+		Length:  4000,
+		Weights: map[byte]int64{'a': 3, 'b': 1, 'c': 0},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	counts := make(map[byte]int)
+	for _, c := range result {
+		counts[c]++
+	}
+
+	if len(result) != 4000 {
+		t.Errorf("expected length 4000, got %d", len(result))
+	}
+
+	if counts['c'] != 0 {
+		t.Errorf("expected no occurrences of zero-weight character, got %d", counts['c'])
+	}
+
+	// 3000 expected occurrences, with a standard deviation of roughly 27.
+	if counts['a'] < 2700 || counts['a'] > 3300 {
+		t.Errorf("expected roughly 3000 occurrences of a, got %d", counts['a'])
+	}
+
+	if counts['a']+counts['b'] != 4000 {
+		t.Errorf("expected only a and b, got %v", counts)
+	}
+}
+
+func TestCreateString_AlternateCase(t *testing.T) {
+	result, err := CreateString(StringParams{
+		Length:        4000,
+		Upper:         true,
+		Lower:         true,
+		Numeric:       true,
+		AlternateCase: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var upper, lower, numeric int
+	for _, c := range result {
+		switch {
+		case c >= 'A' && c <= 'Z':
+			upper++
+		case c >= 'a' && c <= 'z':
+			lower++
+		case c >= '0' && c <= '9':
+			numeric++
+		default:
+			t.Fatalf("unexpected character %q", c)
+		}
+	}
+
+	// Letters are drawn from a single alphabet, so 26 of every 36 characters are expected to be letters, i.e.
+	// roughly 2889 with a standard deviation of roughly 28.
+	if letters := upper + lower; letters < 2750 || letters > 3030 {
+		t.Errorf("expected roughly 2889 letters, got %d", letters)
+	}
+
+	// Each letter is equally likely to be either case, so the difference between the two counts has a standard
+	// deviation of roughly 54 for 2889 letters.
+	if upper < lower-270 || upper > lower+270 {
+		t.Errorf("expected roughly as many uppercase as lowercase letters, got %d and %d", upper, lower)
+	}
+}
+
+func TestCreateString_WeightsZeroTotal(t *testing.T) {
+	_, err := CreateString(StringParams{
+		Length:  4,
+		Weights: map[byte]int64{'a': 0},
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestInferStringParams(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected StringParams
+	}{
+		{
+			name:     "lower only",
+			input:    "test",
+			expected: StringParams{Length: 4, Lower: true},
+		},
+		{
+			name:     "alphanumeric",
+			input:    "Abc123",
+			expected: StringParams{Length: 6, Upper: true, Lower: true, Numeric: true},
+		},
+		{
+			name:     "special",
+			input:    "DZy_3*tnonj%Q%Yx",
+			expected: StringParams{Length: 16, Upper: true, Lower: true, Numeric: true, Special: true},
+		},
+		{
+			name:     "multi-byte",
+			input:    "ü9",
+			expected: StringParams{Length: 2, Numeric: true, Special: true},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := InferStringParams(c.input); actual.Length != c.expected.Length ||
+				actual.Upper != c.expected.Upper || actual.Lower != c.expected.Lower ||
+				actual.Numeric != c.expected.Numeric || actual.Special != c.expected.Special {
+				t.Errorf("expected %+v, got %+v", c.expected, actual)
+			}
+		})
+	}
+}
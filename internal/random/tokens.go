@@ -0,0 +1,55 @@
+package random
+
+import (
+	"strings"
+)
+
+// TokenParams describes a string made up of tokens drawn with replacement, e.g. syllables that form readable
+// identifiers.
+type TokenParams struct {
+	Tokens    []string
+	Count     int64
+	Separator string
+	// Exclude lists results that must not be returned. A result that appears in Exclude is
+	// re-drawn, up to MaxAttempts times, after which ErrMaxAttempts is returned.
+	Exclude []string
+}
+
+// CreateTokenString returns input.Count tokens drawn with replacement from input.Tokens, joined by
+// input.Separator. Every draw uses a cryptographic random number generator.
+func CreateTokenString(input TokenParams) ([]byte, error) {
+	if len(input.Tokens) == 0 {
+		return nil, newError(ErrRangeEmpty, "the token list needs to contain at least one token")
+	}
+
+	if input.Count < 1 {
+		return nil, newError(ErrInvalidParams, "the string needs to contain at least one token")
+	}
+
+	excluded := make(map[string]struct{}, len(input.Exclude))
+	for _, v := range input.Exclude {
+		excluded[v] = struct{}{}
+	}
+
+	parts := make([]string, input.Count)
+
+	for i := 0; i < MaxAttempts; i++ {
+		for j := range parts {
+			idx, err := randomIndex(len(input.Tokens))
+			if err != nil {
+				return nil, err
+			}
+
+			parts[j] = input.Tokens[idx]
+		}
+
+		result := strings.Join(parts, input.Separator)
+		if _, ok := excluded[result]; ok {
+			continue
+		}
+
+		return []byte(result), nil
+	}
+
+	return nil, ErrMaxAttempts
+}
@@ -0,0 +1,76 @@
+package random
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestCreateTokenString(t *testing.T) {
+	cases := []struct {
+		name     string
+		params   TokenParams
+		expected *regexp.Regexp
+	}{
+		{
+			name:     "separator",
+			params:   TokenParams{Tokens: []string{"ka", "lo", "mi"}, Count: 3, Separator: "-"},
+			expected: regexp.MustCompile(`^(ka|lo|mi)-(ka|lo|mi)-(ka|lo|mi)$`),
+		},
+		{
+			name:     "no separator",
+			params:   TokenParams{Tokens: []string{"ka", "lo"}, Count: 2},
+			expected: regexp.MustCompile(`^(ka|lo)(ka|lo)$`),
+		},
+		{
+			name:     "exclude",
+			params:   TokenParams{Tokens: []string{"a", "b"}, Count: 1, Exclude: []string{"a"}},
+			expected: regexp.MustCompile(`^b$`),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := CreateTokenString(c.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !c.expected.Match(actual) {
+				t.Errorf("expected %q to match %s", actual, c.expected)
+			}
+		})
+	}
+}
+
+func TestCreateTokenString_Errors(t *testing.T) {
+	cases := []struct {
+		name     string
+		params   TokenParams
+		expected error
+	}{
+		{
+			name:     "no tokens",
+			params:   TokenParams{Count: 2},
+			expected: ErrRangeEmpty,
+		},
+		{
+			name:     "no count",
+			params:   TokenParams{Tokens: []string{"ka"}},
+			expected: ErrInvalidParams,
+		},
+		{
+			name:     "everything excluded",
+			params:   TokenParams{Tokens: []string{"ka"}, Count: 2, Exclude: []string{"kaka"}},
+			expected: ErrMaxAttempts,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := CreateTokenString(c.params); !errors.Is(err, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, err)
+			}
+		})
+	}
+}
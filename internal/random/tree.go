@@ -0,0 +1,97 @@
+package random
+
+import (
+	"math/rand"
+)
+
+// MaxTreeNodes bounds the number of nodes a tree described by TreeParams may contain in the worst case.
+const MaxTreeNodes = 10000
+
+const treeKeyLength = 8
+
+type TreeParams struct {
+	// MaxDepth is the maximum number of levels of nested maps, including the root.
+	MaxDepth int64
+	// MaxBreadth is the maximum number of entries in each map.
+	MaxBreadth int64
+	// LeafType is the type of leaf values, one of "string", "number" or "bool".
+	LeafType string
+}
+
+// TreeNodeLimit returns the number of nodes in a tree in which every map has MaxBreadth entries and every leaf is
+// at depth MaxDepth, i.e. the largest tree that may be generated for the given depth and breadth. It stops counting
+// once the total exceeds limit, so that the result does not overflow.
+func TreeNodeLimit(maxDepth, maxBreadth, limit int64) int64 {
+	total, level := int64(1), int64(1)
+
+	for d := int64(0); d < maxDepth && total <= limit; d++ {
+		if level > limit/maxBreadth {
+			return limit + 1
+		}
+		level *= maxBreadth
+		total += level
+	}
+
+	return total
+}
+
+// CreateTree generates a random tree of nested maps. The root is always a map. Every other entry is a leaf value
+// of the configured type, or, until MaxDepth is reached, with equal probability a nested map. Each map has between
+// 1 and MaxBreadth entries, keyed by random lowercase strings.
+func CreateTree(rand *rand.Rand, input TreeParams) (map[string]interface{}, error) {
+	if input.MaxDepth < 1 || input.MaxBreadth < 1 {
+		return nil, newError(ErrInvalidParams, "the maximum depth and breadth of the tree need to be at least 1")
+	}
+
+	if TreeNodeLimit(input.MaxDepth, input.MaxBreadth, MaxTreeNodes) > MaxTreeNodes {
+		return nil, newError(ErrConstraintUnsatisfiable, "the tree could contain more than the maximum number of nodes")
+	}
+
+	switch input.LeafType {
+	case "string", "number", "bool":
+	default:
+		return nil, newError(ErrInvalidParams, "the leaf type needs to be one of string, number or bool")
+	}
+
+	return createTreeNode(rand, input, 1), nil
+}
+
+func createTreeNode(rand *rand.Rand, input TreeParams, depth int64) map[string]interface{} {
+	breadth := rand.Int63n(input.MaxBreadth) + 1
+	node := make(map[string]interface{}, breadth)
+
+	for int64(len(node)) < breadth {
+		key := createTreeKey(rand)
+		if _, ok := node[key]; ok {
+			continue
+		}
+
+		if depth < input.MaxDepth && rand.Intn(2) == 0 {
+			node[key] = createTreeNode(rand, input, depth+1)
+		} else {
+			node[key] = createTreeLeaf(rand, input.LeafType)
+		}
+	}
+
+	return node
+}
+
+func createTreeKey(rand *rand.Rand) string {
+	key := make([]byte, treeKeyLength)
+	for i := range key {
+		key[i] = lowerChars[rand.Intn(len(lowerChars))]
+	}
+
+	return string(key)
+}
+
+func createTreeLeaf(rand *rand.Rand, leafType string) interface{} {
+	switch leafType {
+	case "number":
+		return rand.Intn(1000)
+	case "bool":
+		return rand.Intn(2) == 0
+	default:
+		return createTreeKey(rand)
+	}
+}
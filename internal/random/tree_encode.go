@@ -0,0 +1,89 @@
+package random
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// EncodeTreeYAML encodes a tree generated by CreateTree as a YAML document, with the keys of each map sorted.
+func EncodeTreeYAML(tree map[string]interface{}) string {
+	var b strings.Builder
+	encodeTreeYAML(&b, tree, "")
+
+	return b.String()
+}
+
+func encodeTreeYAML(b *strings.Builder, node map[string]interface{}, indent string) {
+	for _, k := range sortedTreeKeys(node) {
+		if child, ok := node[k].(map[string]interface{}); ok {
+			fmt.Fprintf(b, "%s%s:\n", indent, k)
+			encodeTreeYAML(b, child, indent+"  ")
+			continue
+		}
+
+		fmt.Fprintf(b, "%s%s: %s\n", indent, k, encodeTreeLeaf(node[k]))
+	}
+}
+
+// EncodeTreeTOML encodes a tree generated by CreateTree as a TOML document, with the keys of each map sorted.
+// The leaf values of each map are written before its nested maps, which become tables.
+func EncodeTreeTOML(tree map[string]interface{}) string {
+	var b strings.Builder
+	encodeTreeTOML(&b, tree, "")
+
+	return b.String()
+}
+
+func encodeTreeTOML(b *strings.Builder, node map[string]interface{}, table string) {
+	keys := sortedTreeKeys(node)
+
+	for _, k := range keys {
+		if _, ok := node[k].(map[string]interface{}); !ok {
+			fmt.Fprintf(b, "%s = %s\n", k, encodeTreeLeaf(node[k]))
+		}
+	}
+
+	for _, k := range keys {
+		child, ok := node[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := k
+		if table != "" {
+			name = table + "." + k
+		}
+
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(b, "[%s]\n", name)
+		encodeTreeTOML(b, child, name)
+	}
+}
+
+// encodeTreeLeaf encodes a leaf value. Keys and string leaves only ever hold lowercase letters, so they need no
+// quoting or escaping beyond the surrounding double quotes, which are valid in both YAML and TOML.
+func encodeTreeLeaf(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func sortedTreeKeys(node map[string]interface{}) []string {
+	keys := make([]string, 0, len(node))
+	for k := range node {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
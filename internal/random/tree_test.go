@@ -0,0 +1,179 @@
+package random
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTreeNodeLimit(t *testing.T) {
+	cases := []struct {
+		name       string
+		maxDepth   int64
+		maxBreadth int64
+		expected   int64
+	}{
+		{
+			name:       "single level",
+			maxDepth:   1,
+			maxBreadth: 3,
+			expected:   4,
+		},
+		{
+			name:       "binary",
+			maxDepth:   3,
+			maxBreadth: 2,
+			expected:   15,
+		},
+		{
+			name:       "over limit",
+			maxDepth:   10,
+			maxBreadth: 10,
+			expected:   101,
+		},
+		{
+			name:       "overflow",
+			maxDepth:   100,
+			maxBreadth: 1 << 40,
+			expected:   101,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := TreeNodeLimit(c.maxDepth, c.maxBreadth, 100)
+
+			if c.expected > 100 && actual <= 100 {
+				t.Errorf("expected a value over the limit, got %d", actual)
+			} else if c.expected <= 100 && actual != c.expected {
+				t.Errorf("expected %d, got %d", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCreateTree(t *testing.T) {
+	params := TreeParams{
+		MaxDepth:   3,
+		MaxBreadth: 4,
+		LeafType:   "number",
+	}
+
+	for i := 0; i < 50; i++ {
+		tree, err := CreateTree(NewRand(fmt.Sprint(i)), params)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := checkTree(tree, params, 1); err != nil {
+			t.Fatalf("seed %d: %s", i, err)
+		}
+	}
+}
+
+func TestCreateTree_Errors(t *testing.T) {
+	cases := []struct {
+		name   string
+		params TreeParams
+	}{
+		{
+			name:   "depth",
+			params: TreeParams{MaxDepth: 0, MaxBreadth: 1, LeafType: "string"},
+		},
+		{
+			name:   "too many nodes",
+			params: TreeParams{MaxDepth: 5, MaxBreadth: 10, LeafType: "string"},
+		},
+		{
+			name:   "leaf type",
+			params: TreeParams{MaxDepth: 1, MaxBreadth: 1, LeafType: "list"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := CreateTree(NewRand("1"), c.params); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func checkTree(node map[string]interface{}, params TreeParams, depth int64) error {
+	if len(node) < 1 || int64(len(node)) > params.MaxBreadth {
+		return fmt.Errorf("depth %d: expected between 1 and %d entries, got %d", depth, params.MaxBreadth, len(node))
+	}
+
+	for k, v := range node {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if depth >= params.MaxDepth {
+				return fmt.Errorf("depth %d: unexpected map at %q", depth, k)
+			}
+			if err := checkTree(v, params, depth+1); err != nil {
+				return err
+			}
+		case int:
+		default:
+			return fmt.Errorf("depth %d: unexpected leaf %#v at %q", depth, v, k)
+		}
+	}
+
+	return nil
+}
+
+func TestEncodeTree(t *testing.T) {
+	tree := map[string]interface{}{
+		"b": "leaf",
+		"a": map[string]interface{}{
+			"c": 42,
+			"d": map[string]interface{}{
+				"e": true,
+			},
+		},
+		"f": map[string]interface{}{
+			"g": false,
+		},
+	}
+
+	cases := []struct {
+		name     string
+		encode   func(map[string]interface{}) string
+		expected string
+	}{
+		{
+			name:   "yaml",
+			encode: EncodeTreeYAML,
+			expected: "a:\n" +
+				"  c: 42\n" +
+				"  d:\n" +
+				"    e: true\n" +
+				"b: \"leaf\"\n" +
+				"f:\n" +
+				"  g: false\n",
+		},
+		{
+			name:   "toml",
+			encode: EncodeTreeTOML,
+			expected: "b = \"leaf\"\n" +
+				"\n" +
+				"[a]\n" +
+				"c = 42\n" +
+				"\n" +
+				"[a.d]\n" +
+				"e = true\n" +
+				"\n" +
+				"[f]\n" +
+				"g = false\n",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.encode(tree)
+
+			if actual != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package random
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	mathrand "math/rand"
+	"time"
+)
+
+// UUIDv7Generator generates version 7 UUIDs, as described by RFC 9562, that are strictly increasing across
+// calls to Generate on the same generator.
+//
+// Each UUID starts with a 48-bit millisecond Unix timestamp followed by 12 random bits. When the clock has
+// not advanced far enough for this prefix to exceed the one generated previously, the previous prefix plus one
+// is used instead, so that the 12 bits act as a counter within the same millisecond. The remaining 62 bits
+// are always random.
+type UUIDv7Generator struct {
+	last uint64
+}
+
+// Generate returns the 16 bytes of a version 7 UUID for the given time.
+func (g *UUIDv7Generator) Generate(now time.Time) ([]byte, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	// The prefix holds the timestamp in its upper 48 bits and the random or counter bits in its lower 12.
+	prefix := uint64(now.UnixMilli())<<12 | uint64(binary.BigEndian.Uint16(b[6:8])&0x0fff)
+	if prefix <= g.last {
+		prefix = g.last + 1
+	}
+	g.last = prefix
+
+	binary.BigEndian.PutUint64(b[0:8], (prefix>>12)<<16|prefix&0x0fff)
+	b[6] = 0x70 | b[6]&0x0f
+	b[8] = 0x80 | b[8]&0x3f
+
+	return b, nil
+}
+
+// SeededUUIDv4 returns the 16 bytes of a version 4 UUID drawn from rand, so that the same seed always produces the
+// same UUID. As rand is not a cryptographic random number generator, such UUIDs are not unguessable.
+func SeededUUIDv4(rand *mathrand.Rand) []byte {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	// Set the version to 4 and the variant to RFC 4122.
+	b[6] = 0x40 | b[6]&0x0f
+	b[8] = 0x80 | b[8]&0x3f
+
+	return b
+}
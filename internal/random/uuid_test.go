@@ -0,0 +1,52 @@
+package random
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestUUIDv7Generator_Monotonic(t *testing.T) {
+	var generator UUIDv7Generator
+
+	// A fixed clock forces every UUID after the first onto the counter fallback.
+	now := time.UnixMilli(1700000000000)
+
+	var previous []byte
+	for i := 0; i < 10000; i++ {
+		b, err := generator.Generate(now)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if b[6]>>4 != 7 {
+			t.Fatalf("expected version 7, got %d", b[6]>>4)
+		}
+
+		if b[8]>>6 != 2 {
+			t.Fatalf("expected RFC 9562 variant, got %b", b[8]>>6)
+		}
+
+		if previous != nil && bytes.Compare(previous, b) >= 0 {
+			t.Fatalf("expected %x to be greater than %x", b, previous)
+		}
+
+		previous = b
+	}
+}
+
+func TestUUIDv7Generator_Timestamp(t *testing.T) {
+	var generator UUIDv7Generator
+
+	now := time.UnixMilli(0x0123456789ab)
+
+	b, err := generator.Generate(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab}
+	if !bytes.Equal(b[:6], expected) {
+		t.Errorf("expected timestamp %x, got %x", expected, b[:6])
+	}
+}